@@ -0,0 +1,226 @@
+package cache
+
+import (
+	"container/list"
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/ONSdigital/log.go/v2/log"
+)
+
+// LoadFunc loads a value that is not in the cache
+type LoadFunc func(ctx context.Context) (interface{}, error)
+
+// Stats are the counters of a cache, for monitoring
+type Stats struct {
+	Hits   uint64 `json:"hits"`
+	Misses uint64 `json:"misses"`
+	Size   int    `json:"size"`
+}
+
+// Cache is an in-memory cache with a maximum number of entries, evicting the least recently used entry when full.
+// Entries expire once they are older than the TTL. Concurrent misses for the same key are collapsed into a single
+// load, which is not cancelled with the request that started it, as other requests may be waiting for it.
+type Cache struct {
+	size        int
+	ttl         time.Duration
+	loadTimeout time.Duration
+	now         func() time.Time
+
+	mu      sync.Mutex
+	entries map[string]*list.Element
+	order   *list.List
+	loads   map[string]*load
+
+	hits   uint64
+	misses uint64
+}
+
+type entry struct {
+	key     string
+	value   interface{}
+	fetched time.Time
+	expires time.Time
+}
+
+// load is a load in progress, which callers that miss on the same key wait for
+type load struct {
+	done    chan struct{}
+	value   interface{}
+	fetched time.Time
+	err     error
+	// evicted is set when the key is evicted during the load, as the loaded value may already be out of date
+	evicted bool
+}
+
+// detachedContext keeps the values of a context, such as its trace, without its cancellation or deadline
+type detachedContext struct {
+	context.Context
+}
+
+func (detachedContext) Deadline() (time.Time, bool) { return time.Time{}, false }
+func (detachedContext) Done() <-chan struct{}       { return nil }
+func (detachedContext) Err() error                  { return nil }
+
+// New creates a cache holding up to size entries, each for up to ttl. A load can take up to loadTimeout, or any
+// length of time if it is 0.
+func New(size int, ttl, loadTimeout time.Duration) *Cache {
+	return &Cache{
+		size:        size,
+		ttl:         ttl,
+		loadTimeout: loadTimeout,
+		now:         time.Now,
+		entries:     make(map[string]*list.Element),
+		order:       list.New(),
+		loads:       make(map[string]*load),
+	}
+}
+
+// Get returns the value cached for key, calling loadFn to load it if it is not cached or has expired. Errors are
+// not cached. Each caller waits for the load until its own context is done.
+func (c *Cache) Get(ctx context.Context, key string, loadFn LoadFunc) (interface{}, error) {
+	value, _, err := c.GetFetched(ctx, key, loadFn)
+	return value, err
+}
+
+// GetFetched is Get, also returning the time that the value was loaded, which is earlier than now for a cache hit
+func (c *Cache) GetFetched(ctx context.Context, key string, loadFn LoadFunc) (interface{}, time.Time, error) {
+	c.mu.Lock()
+	if e, ok := c.get(key); ok {
+		c.mu.Unlock()
+		atomic.AddUint64(&c.hits, 1)
+		return e.value, e.fetched, nil
+	}
+	atomic.AddUint64(&c.misses, 1)
+
+	l, ok := c.loads[key]
+	if !ok {
+		l = &load{done: make(chan struct{})}
+		c.loads[key] = l
+		go c.load(ctx, key, l, loadFn)
+	}
+	c.mu.Unlock()
+
+	select {
+	case <-l.done:
+		return l.value, l.fetched, l.err
+	case <-ctx.Done():
+		return nil, time.Time{}, ctx.Err()
+	}
+}
+
+// load calls loadFn with the values of the context of the request that missed, but not its cancellation, and caches
+// the value that it loads
+func (c *Cache) load(ctx context.Context, key string, l *load, loadFn LoadFunc) {
+	var loadCtx context.Context = detachedContext{ctx}
+	if c.loadTimeout > 0 {
+		var cancel context.CancelFunc
+		loadCtx, cancel = context.WithTimeout(loadCtx, c.loadTimeout)
+		defer cancel()
+	}
+
+	value, err := loadFn(loadCtx)
+
+	c.mu.Lock()
+	l.value, l.fetched, l.err = value, c.now(), err
+	delete(c.loads, key)
+	if err == nil && !l.evicted {
+		c.set(key, value, l.fetched)
+	}
+	c.mu.Unlock()
+	close(l.done)
+}
+
+// Stats returns the current counters of the cache
+func (c *Cache) Stats() Stats {
+	c.mu.Lock()
+	size := c.order.Len()
+	c.mu.Unlock()
+
+	return Stats{
+		Hits:   atomic.LoadUint64(&c.hits),
+		Misses: atomic.LoadUint64(&c.misses),
+		Size:   size,
+	}
+}
+
+// EvictPrefix removes every entry with a key that starts with prefix, returning the number of entries removed
+func (c *Cache) EvictPrefix(prefix string) int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	var evicted int
+	for key, el := range c.entries {
+		if strings.HasPrefix(key, prefix) {
+			c.remove(el)
+			evicted++
+		}
+	}
+	for key, l := range c.loads {
+		if strings.HasPrefix(key, prefix) {
+			l.evicted = true
+		}
+	}
+	return evicted
+}
+
+// StatsHandler writes the counters of the cache as JSON
+func (c *Cache) StatsHandler(w http.ResponseWriter, req *http.Request) {
+	data, err := json.Marshal(c.Stats())
+	if err != nil {
+		log.Error(req.Context(), "failed to marshal cache stats", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("content-type", "application/json")
+	if _, err = w.Write(data); err != nil {
+		log.Error(req.Context(), "failed to write cache stats", err)
+	}
+}
+
+// get must be called with the lock held
+func (c *Cache) get(key string) (*entry, bool) {
+	el, ok := c.entries[key]
+	if !ok {
+		return nil, false
+	}
+
+	e := el.Value.(*entry)
+	if !c.now().Before(e.expires) {
+		c.remove(el)
+		return nil, false
+	}
+
+	c.order.MoveToFront(el)
+	return e, true
+}
+
+// set must be called with the lock held
+func (c *Cache) set(key string, value interface{}, fetched time.Time) {
+	if el, ok := c.entries[key]; ok {
+		c.remove(el)
+	}
+
+	c.entries[key] = c.order.PushFront(&entry{
+		key:     key,
+		value:   value,
+		fetched: fetched,
+		expires: fetched.Add(c.ttl),
+	})
+
+	for c.order.Len() > c.size {
+		c.remove(c.order.Back())
+	}
+}
+
+// remove must be called with the lock held
+func (c *Cache) remove(el *list.Element) {
+	c.order.Remove(el)
+	delete(c.entries, el.Value.(*entry).key)
+}
@@ -0,0 +1,253 @@
+package cache
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestUnitCache(t *testing.T) {
+	ctx := context.Background()
+
+	Convey("Given a cache", t, func() {
+		now := time.Date(2023, 1, 1, 9, 30, 0, 0, time.UTC)
+		c := New(2, time.Minute, time.Second)
+		c.now = func() time.Time { return now }
+
+		var loads int
+		loader := func(value string) LoadFunc {
+			return func(ctx context.Context) (interface{}, error) {
+				loads++
+				return value, nil
+			}
+		}
+
+		Convey("When a key is requested twice", func() {
+			first, err := c.Get(ctx, "a", loader("value a"))
+			So(err, ShouldBeNil)
+			second, err := c.Get(ctx, "a", loader("another value"))
+			So(err, ShouldBeNil)
+
+			Convey("Then the value is only loaded once", func() {
+				So(first, ShouldEqual, "value a")
+				So(second, ShouldEqual, "value a")
+				So(loads, ShouldEqual, 1)
+			})
+
+			Convey("And the hit and miss are counted", func() {
+				So(c.Stats(), ShouldResemble, Stats{Hits: 1, Misses: 1, Size: 1})
+			})
+		})
+
+		Convey("When a cached key is requested later", func() {
+			loaded := now
+			_, first, _ := c.GetFetched(ctx, "a", loader("value a"))
+			now = now.Add(30 * time.Second)
+			_, second, _ := c.GetFetched(ctx, "a", loader("another value"))
+
+			Convey("Then the time that the value was loaded is returned", func() {
+				So(first, ShouldEqual, loaded)
+				So(second, ShouldEqual, loaded)
+			})
+		})
+
+		Convey("When an entry is older than the TTL", func() {
+			c.Get(ctx, "a", loader("value a"))
+			now = now.Add(time.Minute)
+			value, _ := c.Get(ctx, "a", loader("new value a"))
+
+			Convey("Then it is loaded again", func() {
+				So(value, ShouldEqual, "new value a")
+				So(loads, ShouldEqual, 2)
+			})
+		})
+
+		Convey("When more keys are requested than the cache can hold", func() {
+			c.Get(ctx, "a", loader("value a"))
+			c.Get(ctx, "b", loader("value b"))
+			c.Get(ctx, "a", loader("value a"))
+			c.Get(ctx, "c", loader("value c"))
+
+			Convey("Then the least recently used entry is evicted", func() {
+				So(c.Stats().Size, ShouldEqual, 2)
+				So(loads, ShouldEqual, 3)
+
+				c.Get(ctx, "a", loader("value a"))
+				So(loads, ShouldEqual, 3)
+				c.Get(ctx, "b", loader("value b"))
+				So(loads, ShouldEqual, 4)
+			})
+		})
+
+		Convey("When a load fails", func() {
+			loadErr := errors.New("load failed")
+			_, err := c.Get(ctx, "a", func(ctx context.Context) (interface{}, error) {
+				return nil, loadErr
+			})
+
+			Convey("Then the error is returned and not cached", func() {
+				So(err, ShouldEqual, loadErr)
+				So(c.Stats().Size, ShouldEqual, 0)
+
+				value, err := c.Get(ctx, "a", loader("value a"))
+				So(err, ShouldBeNil)
+				So(value, ShouldEqual, "value a")
+			})
+		})
+
+		Convey("When a key is missed by concurrent requests", func() {
+			var concurrentLoads int32
+			release := make(chan struct{})
+			slowLoader := func(ctx context.Context) (interface{}, error) {
+				atomic.AddInt32(&concurrentLoads, 1)
+				<-release
+				return "value a", nil
+			}
+
+			var wg sync.WaitGroup
+			values := make([]interface{}, 5)
+			for i := range values {
+				wg.Add(1)
+				go func(i int) {
+					defer wg.Done()
+					values[i], _ = c.Get(ctx, "a", slowLoader)
+				}(i)
+			}
+			for c.Stats().Misses < uint64(len(values)) {
+				time.Sleep(time.Millisecond)
+			}
+			close(release)
+			wg.Wait()
+
+			Convey("Then the misses are collapsed into a single load", func() {
+				So(atomic.LoadInt32(&concurrentLoads), ShouldEqual, 1)
+				for _, v := range values {
+					So(v, ShouldEqual, "value a")
+				}
+			})
+		})
+
+		Convey("When the request that started a load is cancelled", func() {
+			release := make(chan struct{})
+			var loadErr error
+			slowLoader := func(ctx context.Context) (interface{}, error) {
+				<-release
+				loadErr = ctx.Err()
+				return "value a", nil
+			}
+
+			firstCtx, cancel := context.WithCancel(ctx)
+			firstErr := make(chan error)
+			go func() {
+				_, err := c.Get(firstCtx, "a", slowLoader)
+				firstErr <- err
+			}()
+			for c.Stats().Misses < 1 {
+				time.Sleep(time.Millisecond)
+			}
+			cancel()
+			cancelledErr := <-firstErr
+
+			waiterValue := make(chan interface{})
+			go func() {
+				value, _ := c.Get(ctx, "a", slowLoader)
+				waiterValue <- value
+			}()
+			for c.Stats().Misses < 2 {
+				time.Sleep(time.Millisecond)
+			}
+			close(release)
+
+			Convey("Then only that request fails", func() {
+				So(cancelledErr, ShouldEqual, context.Canceled)
+				So(<-waiterValue, ShouldEqual, "value a")
+			})
+
+			Convey("Then the load completes and is cached", func() {
+				<-waiterValue
+				So(loadErr, ShouldBeNil)
+				So(c.Stats().Size, ShouldEqual, 1)
+			})
+		})
+
+		Convey("When a request waiting for a load is cancelled", func() {
+			release := make(chan struct{})
+			defer close(release)
+			slowLoader := func(ctx context.Context) (interface{}, error) {
+				<-release
+				return "value a", nil
+			}
+			go c.Get(ctx, "a", slowLoader)
+			for c.Stats().Misses < 1 {
+				time.Sleep(time.Millisecond)
+			}
+
+			waiterCtx, cancel := context.WithCancel(ctx)
+			cancel()
+			_, err := c.Get(waiterCtx, "a", slowLoader)
+
+			Convey("Then it stops waiting", func() {
+				So(err, ShouldEqual, context.Canceled)
+			})
+		})
+
+		Convey("When a load takes longer than the load timeout", func() {
+			c.loadTimeout = 10 * time.Millisecond
+			var hasDeadline bool
+			_, err := c.Get(ctx, "a", func(ctx context.Context) (interface{}, error) {
+				_, hasDeadline = ctx.Deadline()
+				<-ctx.Done()
+				return nil, ctx.Err()
+			})
+
+			Convey("Then it fails with the deadline of the load", func() {
+				So(hasDeadline, ShouldBeTrue)
+				So(errors.Is(err, context.DeadlineExceeded), ShouldBeTrue)
+				So(c.Stats().Size, ShouldEqual, 0)
+			})
+		})
+
+		Convey("When entries are evicted by prefix", func() {
+			c.Get(ctx, "/a/bulletin|breadcrumb|en", loader("breadcrumb"))
+			c.Get(ctx, "/another/bulletin|breadcrumb|en", loader("another breadcrumb"))
+
+			Convey("Then only the matching entries are removed", func() {
+				So(c.EvictPrefix("/a/"), ShouldEqual, 1)
+				So(c.Stats().Size, ShouldEqual, 1)
+			})
+		})
+
+		Convey("When an entry is evicted while it is being loaded", func() {
+			_, err := c.Get(ctx, "/a/bulletin|breadcrumb|en", func(ctx context.Context) (interface{}, error) {
+				c.EvictPrefix("/a/bulletin")
+				return "out of date breadcrumb", nil
+			})
+			So(err, ShouldBeNil)
+
+			Convey("Then the loaded value is not cached", func() {
+				So(c.Stats().Size, ShouldEqual, 0)
+			})
+		})
+
+		Convey("When the stats are requested over http", func() {
+			c.Get(ctx, "a", loader("value a"))
+			w := httptest.NewRecorder()
+			c.StatsHandler(w, httptest.NewRequest("GET", "/cache/stats", nil))
+
+			Convey("Then they are returned as json", func() {
+				var stats Stats
+				So(w.Code, ShouldEqual, http.StatusOK)
+				So(json.Unmarshal(w.Body.Bytes(), &stats), ShouldBeNil)
+				So(stats, ShouldResemble, Stats{Misses: 1, Size: 1})
+			})
+		})
+	})
+}
@@ -0,0 +1,99 @@
+package cache
+
+import (
+	"context"
+	"strings"
+
+	"github.com/ONSdigital/dp-api-clients-go/v2/articles"
+	"github.com/ONSdigital/dp-api-clients-go/v2/zebedee"
+	"github.com/ONSdigital/dp-frontend-articles-controller/handlers"
+)
+
+// ArticlesAPIClient caches the published content requested from the Articles API
+type ArticlesAPIClient struct {
+	handlers.ArticlesApiClient
+	cache *Cache
+}
+
+// NewArticlesAPIClient wraps an Articles API client with the cache
+func NewArticlesAPIClient(ac handlers.ArticlesApiClient, c *Cache) *ArticlesAPIClient {
+	return &ArticlesAPIClient{
+		ArticlesApiClient: ac,
+		cache:             c,
+	}
+}
+
+// GetLegacyBulletin returns a legacy bulletin, from the cache if it is published content
+func (c *ArticlesAPIClient) GetLegacyBulletin(ctx context.Context, userAccessToken, collectionID, lang, uri string) (*articles.Bulletin, error) {
+	if !IsPublished(userAccessToken, collectionID) {
+		return c.ArticlesApiClient.GetLegacyBulletin(ctx, userAccessToken, collectionID, lang, uri)
+	}
+
+	value, fetched, err := c.cache.GetFetched(ctx, Key("legacy-bulletin", lang, uri), func(ctx context.Context) (interface{}, error) {
+		return c.ArticlesApiClient.GetLegacyBulletin(ctx, userAccessToken, collectionID, lang, uri)
+	})
+	if err != nil {
+		return nil, err
+	}
+	setFetched(ctx, fetched)
+
+	// Callers are given their own copy, so that the cached bulletin cannot be modified
+	return CopyBulletin(value.(*articles.Bulletin)), nil
+}
+
+// ZebedeeClient caches the published content requested from Zebedee
+type ZebedeeClient struct {
+	handlers.ZebedeeClient
+	cache *Cache
+}
+
+// NewZebedeeClient wraps a Zebedee client with the cache
+func NewZebedeeClient(zc handlers.ZebedeeClient, c *Cache) *ZebedeeClient {
+	return &ZebedeeClient{
+		ZebedeeClient: zc,
+		cache:         c,
+	}
+}
+
+// GetBreadcrumb returns the breadcrumb for a page, from the cache if it is published content
+func (c *ZebedeeClient) GetBreadcrumb(ctx context.Context, userAccessToken, collectionID, lang, uri string) ([]zebedee.Breadcrumb, error) {
+	if !IsPublished(userAccessToken, collectionID) {
+		return c.ZebedeeClient.GetBreadcrumb(ctx, userAccessToken, collectionID, lang, uri)
+	}
+
+	value, fetched, err := c.cache.GetFetched(ctx, Key("breadcrumb", lang, uri), func(ctx context.Context) (interface{}, error) {
+		return c.ZebedeeClient.GetBreadcrumb(ctx, userAccessToken, collectionID, lang, uri)
+	})
+	if err != nil {
+		return nil, err
+	}
+	setFetched(ctx, fetched)
+	return append([]zebedee.Breadcrumb(nil), value.([]zebedee.Breadcrumb)...), nil
+}
+
+// GetHomepageContent returns the homepage content, from the cache if it is published content
+func (c *ZebedeeClient) GetHomepageContent(ctx context.Context, userAccessToken, collectionID, lang, path string) (zebedee.HomepageContent, error) {
+	if !IsPublished(userAccessToken, collectionID) {
+		return c.ZebedeeClient.GetHomepageContent(ctx, userAccessToken, collectionID, lang, path)
+	}
+
+	value, fetched, err := c.cache.GetFetched(ctx, Key("homepage-content", lang, path), func(ctx context.Context) (interface{}, error) {
+		return c.ZebedeeClient.GetHomepageContent(ctx, userAccessToken, collectionID, lang, path)
+	})
+	if err != nil {
+		return zebedee.HomepageContent{}, err
+	}
+	setFetched(ctx, fetched)
+	return value.(zebedee.HomepageContent), nil
+}
+
+// IsPublished reports whether a request is for published content, rather than a preview of content in a collection
+func IsPublished(userAccessToken, collectionID string) bool {
+	return userAccessToken == "" && collectionID == ""
+}
+
+// Key creates the key for content of a kind, e.g. "legacy-bulletin", in a language. The key starts with the URI of the
+// content, so that content can be evicted by URI prefix.
+func Key(kind, lang, uri string) string {
+	return strings.Join([]string{uri, kind, lang}, "|")
+}
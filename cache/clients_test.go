@@ -0,0 +1,145 @@
+package cache
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ONSdigital/dp-api-clients-go/v2/articles"
+	"github.com/ONSdigital/dp-api-clients-go/v2/zebedee"
+	"github.com/ONSdigital/dp-frontend-articles-controller/handlers"
+	gomock "github.com/golang/mock/gomock"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestUnitClients(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	ctx := context.Background()
+	anyCtx := gomock.Any()
+
+	Convey("Given clients wrapped with a cache", t, func() {
+		c := New(10, time.Minute, 0)
+		mockArticlesApiClient := handlers.NewMockArticlesApiClient(mockCtrl)
+		mockZebedeeClient := handlers.NewMockZebedeeClient(mockCtrl)
+		ac := NewArticlesAPIClient(mockArticlesApiClient, c)
+		zc := NewZebedeeClient(mockZebedeeClient, c)
+
+		Convey("When published content is requested twice", func() {
+			b := &articles.Bulletin{URI: "/a/bulletin", Type: "bulletin"}
+			bcs := []zebedee.Breadcrumb{{URI: "/"}}
+			hc := zebedee.HomepageContent{ServiceMessage: "a service message"}
+			mockArticlesApiClient.EXPECT().GetLegacyBulletin(anyCtx, "", "", "en", "/a/bulletin").Return(b, nil).Times(1)
+			mockZebedeeClient.EXPECT().GetBreadcrumb(anyCtx, "", "", "en", "/a/bulletin").Return(bcs, nil).Times(1)
+			mockZebedeeClient.EXPECT().GetHomepageContent(anyCtx, "", "", "en", "/").Return(hc, nil).Times(1)
+
+			for i := 0; i < 2; i++ {
+				bulletin, err := ac.GetLegacyBulletin(ctx, "", "", "en", "/a/bulletin")
+				So(err, ShouldBeNil)
+				So(bulletin, ShouldResemble, b)
+				So(bulletin, ShouldNotPointTo, b)
+
+				breadcrumbs, err := zc.GetBreadcrumb(ctx, "", "", "en", "/a/bulletin")
+				So(err, ShouldBeNil)
+				So(breadcrumbs, ShouldResemble, bcs)
+
+				homepageContent, err := zc.GetHomepageContent(ctx, "", "", "en", "/")
+				So(err, ShouldBeNil)
+				So(homepageContent, ShouldResemble, hc)
+			}
+
+			Convey("Then each upstream call is only made once", func() {
+				So(c.Stats(), ShouldResemble, Stats{Hits: 3, Misses: 3, Size: 3})
+			})
+		})
+
+		Convey("When published content is requested with a record of when it was fetched", func() {
+			b := &articles.Bulletin{URI: "/a/bulletin", Type: "bulletin"}
+			mockArticlesApiClient.EXPECT().GetLegacyBulletin(anyCtx, "", "", "en", "/a/bulletin").Return(b, nil).Times(1)
+			mockArticlesApiClient.EXPECT().GetLegacyBulletin(anyCtx, "token", "collection", "en", "/a/bulletin").Return(b, nil).Times(1)
+
+			firstCtx, first := WithFetched(ctx)
+			ac.GetLegacyBulletin(firstCtx, "", "", "en", "/a/bulletin")
+			secondCtx, second := WithFetched(ctx)
+			ac.GetLegacyBulletin(secondCtx, "", "", "en", "/a/bulletin")
+			previewCtx, preview := WithFetched(ctx)
+			ac.GetLegacyBulletin(previewCtx, "token", "collection", "en", "/a/bulletin")
+
+			Convey("Then the time that the content was fetched from upstream is recorded", func() {
+				So(first.At(), ShouldNotBeZeroValue)
+				So(second.At(), ShouldEqual, first.At())
+			})
+
+			Convey("Then no time is recorded for content that is not cached", func() {
+				So(preview.At(), ShouldBeZeroValue)
+			})
+		})
+
+		Convey("When a caller modifies the published content that it is given", func() {
+			b := &articles.Bulletin{
+				URI:      "/a/bulletin",
+				Sections: []zebedee.Section{{Title: "Main points", Markdown: "GDP grew"}},
+				Charts:   []zebedee.Figure{{Title: "Figure 1", URI: "/a/bulletin/1a2b3c4d"}},
+			}
+			bcs := []zebedee.Breadcrumb{{URI: "/"}}
+			mockArticlesApiClient.EXPECT().GetLegacyBulletin(anyCtx, "", "", "en", "/a/bulletin").Return(b, nil).Times(1)
+			mockZebedeeClient.EXPECT().GetBreadcrumb(anyCtx, "", "", "en", "/a/bulletin").Return(bcs, nil).Times(1)
+
+			bulletin, _ := ac.GetLegacyBulletin(ctx, "", "", "en", "/a/bulletin")
+			bulletin.Sections[0].Markdown = "modified"
+			bulletin.Charts[0].Title = "modified"
+			breadcrumbs, _ := zc.GetBreadcrumb(ctx, "", "", "en", "/a/bulletin")
+			breadcrumbs[0].URI = "modified"
+
+			Convey("Then the cached content is not modified", func() {
+				bulletin, _ := ac.GetLegacyBulletin(ctx, "", "", "en", "/a/bulletin")
+				So(bulletin.Sections[0].Markdown, ShouldEqual, "GDP grew")
+				So(bulletin.Charts[0].Title, ShouldEqual, "Figure 1")
+				breadcrumbs, _ := zc.GetBreadcrumb(ctx, "", "", "en", "/a/bulletin")
+				So(breadcrumbs[0].URI, ShouldEqual, "/")
+			})
+		})
+
+		Convey("When content is requested in a different language", func() {
+			mockArticlesApiClient.EXPECT().GetLegacyBulletin(anyCtx, "", "", "en", "/a/bulletin").Return(&articles.Bulletin{}, nil).Times(1)
+			mockArticlesApiClient.EXPECT().GetLegacyBulletin(anyCtx, "", "", "cy", "/a/bulletin").Return(&articles.Bulletin{}, nil).Times(1)
+
+			ac.GetLegacyBulletin(ctx, "", "", "en", "/a/bulletin")
+			ac.GetLegacyBulletin(ctx, "", "", "cy", "/a/bulletin")
+
+			Convey("Then it is cached separately", func() {
+				So(c.Stats().Size, ShouldEqual, 2)
+			})
+		})
+
+		Convey("When content in a collection is requested twice", func() {
+			mockArticlesApiClient.EXPECT().GetLegacyBulletin(anyCtx, "token", "collection", "en", "/a/bulletin").Return(&articles.Bulletin{}, nil).Times(2)
+			mockZebedeeClient.EXPECT().GetBreadcrumb(anyCtx, "token", "collection", "en", "/a/bulletin").Times(2)
+			mockZebedeeClient.EXPECT().GetHomepageContent(anyCtx, "", "collection", "en", "/").Times(2)
+
+			for i := 0; i < 2; i++ {
+				ac.GetLegacyBulletin(ctx, "token", "collection", "en", "/a/bulletin")
+				zc.GetBreadcrumb(ctx, "token", "collection", "en", "/a/bulletin")
+				zc.GetHomepageContent(ctx, "", "collection", "en", "/")
+			}
+
+			Convey("Then it is not cached", func() {
+				So(c.Stats(), ShouldResemble, Stats{})
+			})
+		})
+
+		Convey("When a method that is not cached is called", func() {
+			mockZebedeeClient.EXPECT().GetPageTitle(anyCtx, "", "", "en", "/a/bulletin").Return(zebedee.PageTitle{Title: "A bulletin"}, nil).Times(2)
+
+			for i := 0; i < 2; i++ {
+				title, err := zc.GetPageTitle(ctx, "", "", "en", "/a/bulletin")
+				So(err, ShouldBeNil)
+				So(title.Title, ShouldEqual, "A bulletin")
+			}
+
+			Convey("Then it is passed through to the client", func() {
+				So(c.Stats(), ShouldResemble, Stats{})
+			})
+		})
+	})
+}
@@ -0,0 +1,29 @@
+package cache
+
+import (
+	"github.com/ONSdigital/dp-api-clients-go/v2/articles"
+)
+
+// CopyBulletin returns a deep copy of a bulletin, so that a bulletin that is kept in memory is not modified by the
+// callers that it is given to
+func CopyBulletin(b *articles.Bulletin) *articles.Bulletin {
+	if b == nil {
+		return nil
+	}
+
+	c := *b
+	c.RelatedBulletins = append(c.RelatedBulletins[:0:0], b.RelatedBulletins...)
+	c.Sections = append(c.Sections[:0:0], b.Sections...)
+	c.Accordion = append(c.Accordion[:0:0], b.Accordion...)
+	c.RelatedData = append(c.RelatedData[:0:0], b.RelatedData...)
+	c.Charts = append(c.Charts[:0:0], b.Charts...)
+	c.Tables = append(c.Tables[:0:0], b.Tables...)
+	c.Images = append(c.Images[:0:0], b.Images...)
+	c.Equations = append(c.Equations[:0:0], b.Equations...)
+	c.Links = append(c.Links[:0:0], b.Links...)
+	c.Versions = append(c.Versions[:0:0], b.Versions...)
+	c.Alerts = append(c.Alerts[:0:0], b.Alerts...)
+	c.Description.Keywords = append(c.Description.Keywords[:0:0], b.Description.Keywords...)
+	c.Description.CancellationNotice = append(c.Description.CancellationNotice[:0:0], b.Description.CancellationNotice...)
+	return &c
+}
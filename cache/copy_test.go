@@ -0,0 +1,80 @@
+package cache
+
+import (
+	"testing"
+
+	"github.com/ONSdigital/dp-api-clients-go/v2/articles"
+	"github.com/ONSdigital/dp-api-clients-go/v2/zebedee"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestUnitCopyBulletin(t *testing.T) {
+	Convey("Given a bulletin", t, func() {
+		b := &articles.Bulletin{
+			URI:              "/a/bulletin",
+			RelatedBulletins: []zebedee.Link{{URI: "/another/bulletin"}},
+			Sections:         []zebedee.Section{{Title: "Main points"}},
+			Accordion:        []zebedee.Section{{Title: "Glossary"}},
+			RelatedData:      []zebedee.Link{{URI: "/a/dataset"}},
+			Charts:           []zebedee.Figure{{Title: "Figure 1"}},
+			Tables:           []zebedee.Figure{{Title: "Table 1"}},
+			Images:           []zebedee.Figure{{Title: "Image 1"}},
+			Equations:        []zebedee.Figure{{Title: "Equation 1"}},
+			Links:            []zebedee.Link{{URI: "/a/link"}},
+			Versions:         []zebedee.Version{{URI: "/a/bulletin/previous/v1"}},
+			Alerts:           []zebedee.Alert{{Markdown: "A correction"}},
+			Description: zebedee.Description{
+				Keywords:           []string{"gdp"},
+				CancellationNotice: []string{"Cancelled"},
+			},
+		}
+
+		Convey("When it is copied", func() {
+			c := CopyBulletin(b)
+
+			Convey("Then the copy is equal", func() {
+				So(c, ShouldResemble, b)
+				So(c, ShouldNotPointTo, b)
+			})
+
+			Convey("Then modifying the copy does not modify the bulletin", func() {
+				c.RelatedBulletins[0].URI = "modified"
+				c.Sections[0].Title = "modified"
+				c.Accordion[0].Title = "modified"
+				c.RelatedData[0].URI = "modified"
+				c.Charts[0].Title = "modified"
+				c.Tables[0].Title = "modified"
+				c.Images[0].Title = "modified"
+				c.Equations[0].Title = "modified"
+				c.Links[0].URI = "modified"
+				c.Versions[0].URI = "modified"
+				c.Alerts[0].Markdown = "modified"
+				c.Description.Keywords[0] = "modified"
+				c.Description.CancellationNotice[0] = "modified"
+
+				So(b, ShouldResemble, &articles.Bulletin{
+					URI:              "/a/bulletin",
+					RelatedBulletins: []zebedee.Link{{URI: "/another/bulletin"}},
+					Sections:         []zebedee.Section{{Title: "Main points"}},
+					Accordion:        []zebedee.Section{{Title: "Glossary"}},
+					RelatedData:      []zebedee.Link{{URI: "/a/dataset"}},
+					Charts:           []zebedee.Figure{{Title: "Figure 1"}},
+					Tables:           []zebedee.Figure{{Title: "Table 1"}},
+					Images:           []zebedee.Figure{{Title: "Image 1"}},
+					Equations:        []zebedee.Figure{{Title: "Equation 1"}},
+					Links:            []zebedee.Link{{URI: "/a/link"}},
+					Versions:         []zebedee.Version{{URI: "/a/bulletin/previous/v1"}},
+					Alerts:           []zebedee.Alert{{Markdown: "A correction"}},
+					Description: zebedee.Description{
+						Keywords:           []string{"gdp"},
+						CancellationNotice: []string{"Cancelled"},
+					},
+				})
+			})
+		})
+	})
+
+	Convey("CopyBulletin returns nil for a nil bulletin", t, func() {
+		So(CopyBulletin(nil), ShouldBeNil)
+	})
+}
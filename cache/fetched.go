@@ -0,0 +1,32 @@
+package cache
+
+import (
+	"context"
+	"time"
+)
+
+type fetchedKey struct{}
+
+// Fetched records the time that the value returned by a cached call was fetched from the upstream service, which is
+// earlier than the time of the call when the value came from the cache
+type Fetched struct {
+	at time.Time
+}
+
+// WithFetched returns a context for a call to a cached client, and the record of when its value was fetched
+func WithFetched(ctx context.Context) (context.Context, *Fetched) {
+	f := &Fetched{}
+	return context.WithValue(ctx, fetchedKey{}, f), f
+}
+
+// At returns the time that the value was fetched, or zero if the call was not cached
+func (f *Fetched) At() time.Time {
+	return f.at
+}
+
+// setFetched records the time that the value returned to the caller with ctx was fetched, if the caller asked for it
+func setFetched(ctx context.Context, at time.Time) {
+	if f, ok := ctx.Value(fetchedKey{}).(*Fetched); ok {
+		f.at = at
+	}
+}
@@ -0,0 +1,60 @@
+package main
+
+import (
+	"flag"
+	"io"
+	"os"
+	"testing"
+
+	"github.com/ONSdigital/dp-frontend-articles-controller/features/steps"
+	"github.com/ONSdigital/log.go/v2/log"
+	"github.com/cucumber/godog"
+	"github.com/cucumber/godog/colors"
+)
+
+var componentFlag = flag.Bool("component", false, "perform component tests")
+
+type ComponentTest struct {
+	component *steps.Component
+}
+
+func (f *ComponentTest) InitializeScenario(ctx *godog.ScenarioContext) {
+	f.component.RegisterSteps(ctx)
+}
+
+func (f *ComponentTest) InitializeTestSuite(ctx *godog.TestSuiteContext) {
+	ctx.AfterSuite(func() {
+		f.component.Close()
+	})
+}
+
+func TestComponent(t *testing.T) {
+	if !*componentFlag {
+		t.Skip("component flag required to run component tests")
+	}
+
+	// The log output of the service would be interleaved with the output of the scenarios
+	log.SetDestination(io.Discard, io.Discard)
+
+	component, err := steps.NewComponent()
+	if err != nil {
+		t.Fatalf("failed to create component: %v", err)
+	}
+	f := &ComponentTest{component: component}
+
+	status := godog.TestSuite{
+		Name:                 "component_tests",
+		ScenarioInitializer:  f.InitializeScenario,
+		TestSuiteInitializer: f.InitializeTestSuite,
+		Options: &godog.Options{
+			Output: colors.Colored(os.Stdout),
+			Format: "pretty",
+			Paths:  []string{"features"},
+			Strict: true,
+		},
+	}.Run()
+
+	if status != 0 {
+		t.Fail()
+	}
+}
@@ -9,6 +9,7 @@ import (
 // Config represents service configuration for dp-frontend-articles-controller
 type Config struct {
 	BindAddr                   string        `envconfig:"BIND_ADDR"`
+	AdminBindAddr              string        `envconfig:"ADMIN_BIND_ADDR"`
 	Debug                      bool          `envconfig:"DEBUG"`
 	SiteDomain                 string        `envconfig:"SITE_DOMAIN"`
 	PatternLibraryAssetsPath   string        `envconfig:"PATTERN_LIBRARY_ASSETS_PATH"`
@@ -16,10 +17,42 @@ type Config struct {
 	HealthCheckInterval        time.Duration `envconfig:"HEALTHCHECK_INTERVAL"`
 	HealthCheckCriticalTimeout time.Duration `envconfig:"HEALTHCHECK_CRITICAL_TIMEOUT"`
 	APIRouterURL               string        `envconfig:"API_ROUTER_URL"`
+	PDFServiceURL              string        `envconfig:"PDF_SERVICE_URL"`
+	ZebedeeTimeout             time.Duration `envconfig:"ZEBEDEE_TIMEOUT"`
+	ArticlesAPITimeout         time.Duration `envconfig:"ARTICLES_API_TIMEOUT"`
+	HomepageContentTimeout     time.Duration `envconfig:"HOMEPAGE_CONTENT_TIMEOUT"`
+	PDFServiceTimeout          time.Duration `envconfig:"PDF_SERVICE_TIMEOUT"`
+	UpstreamRetries            int           `envconfig:"UPSTREAM_RETRIES"`
+	UpstreamRetryBackoff       time.Duration `envconfig:"UPSTREAM_RETRY_BACKOFF"`
+	CircuitBreakerThreshold    int           `envconfig:"CIRCUIT_BREAKER_THRESHOLD"`
+	CircuitBreakerOpenTimeout  time.Duration `envconfig:"CIRCUIT_BREAKER_OPEN_TIMEOUT"`
+	CacheSize                  int           `envconfig:"CACHE_SIZE"`
+	CacheTTL                   time.Duration `envconfig:"CACHE_TTL"`
+	CacheLoadTimeout           time.Duration `envconfig:"CACHE_LOAD_TIMEOUT"`
+	ReleaseTimes               ReleaseTimes  `envconfig:"RELEASE_TIMES"`
+	StaleContentSize           int           `envconfig:"STALE_CONTENT_SIZE"`
+	StaleContentMaxAge         time.Duration `envconfig:"STALE_CONTENT_MAX_AGE"`
+	CacheControlMaxAge         time.Duration `envconfig:"CACHE_CONTROL_MAX_AGE"`
+	CacheControlSharedMaxAge   time.Duration `envconfig:"CACHE_CONTROL_SHARED_MAX_AGE"`
+	SocialImageURL             string        `envconfig:"SOCIAL_IMAGE_URL"`
+	OTExporterOTLPEndpoint     string        `envconfig:"OTEXPORTER_OTLP_ENDPOINT"`
+	OTServiceName              string        `envconfig:"OTSERVICE_NAME"`
+	OTSamplingRatio            float64       `envconfig:"OTSAMPLING_RATIO"`
+
+	// Clock is the current time that release dates are checked against, replaced in tests
+	Clock func() time.Time `ignored:"true" json:"-"`
 }
 
 var cfg *Config
 
+// Now returns the current time from the clock of the config, or the system clock if it has none
+func (c Config) Now() time.Time {
+	if c.Clock == nil {
+		return time.Now()
+	}
+	return c.Clock()
+}
+
 // Get returns the default config with any modifications through environment
 // variables
 func Get() (*Config, error) {
@@ -43,12 +76,35 @@ func get() (*Config, error) {
 
 	cfg = &Config{
 		BindAddr:                   ":26500",
+		AdminBindAddr:              "localhost:26501",
 		Debug:                      false,
 		SiteDomain:                 "localhost",
 		GracefulShutdownTimeout:    5 * time.Second,
 		HealthCheckInterval:        30 * time.Second,
 		HealthCheckCriticalTimeout: 90 * time.Second,
 		APIRouterURL:               "http://localhost:23200/v1",
+		PDFServiceURL:              "http://localhost:23200/v1",
+		ZebedeeTimeout:             5 * time.Second,
+		ArticlesAPITimeout:         5 * time.Second,
+		HomepageContentTimeout:     time.Second,
+		PDFServiceTimeout:          10 * time.Second,
+		UpstreamRetries:            2,
+		UpstreamRetryBackoff:       100 * time.Millisecond,
+		CircuitBreakerThreshold:    5,
+		CircuitBreakerOpenTimeout:  30 * time.Second,
+		CacheSize:                  1000,
+		CacheTTL:                   time.Minute,
+		CacheLoadTimeout:           15 * time.Second,
+		ReleaseTimes:               ReleaseTimes{7 * time.Hour, 9*time.Hour + 30*time.Minute},
+		StaleContentSize:           1000,
+		StaleContentMaxAge:         time.Hour,
+		CacheControlMaxAge:         5 * time.Minute,
+		CacheControlSharedMaxAge:   15 * time.Minute,
+		SocialImageURL:             "",
+		OTExporterOTLPEndpoint:     "",
+		OTServiceName:              "dp-frontend-articles-controller",
+		OTSamplingRatio:            1,
+		Clock:                      time.Now,
 	}
 
 	return cfg, envconfig.Process("", cfg)
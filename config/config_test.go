@@ -21,6 +21,7 @@ func TestConfig(t *testing.T) {
 
 			Convey("Then the values should be set to the expected defaults", func() {
 				So(cfg.BindAddr, ShouldEqual, ":26500")
+				So(cfg.AdminBindAddr, ShouldEqual, "localhost:26501")
 				So(cfg.Debug, ShouldBeFalse)
 				So(cfg.SiteDomain, ShouldEqual, "localhost")
 				So(cfg.PatternLibraryAssetsPath, ShouldEqual, "//cdn.ons.gov.uk/dp-design-system/dd99d1e")
@@ -28,6 +29,28 @@ func TestConfig(t *testing.T) {
 				So(cfg.HealthCheckInterval, ShouldEqual, 30*time.Second)
 				So(cfg.HealthCheckCriticalTimeout, ShouldEqual, 90*time.Second)
 				So(cfg.APIRouterURL, ShouldEqual, "http://localhost:23200/v1")
+				So(cfg.PDFServiceURL, ShouldEqual, "http://localhost:23200/v1")
+				So(cfg.ZebedeeTimeout, ShouldEqual, 5*time.Second)
+				So(cfg.ArticlesAPITimeout, ShouldEqual, 5*time.Second)
+				So(cfg.HomepageContentTimeout, ShouldEqual, time.Second)
+				So(cfg.PDFServiceTimeout, ShouldEqual, 10*time.Second)
+				So(cfg.UpstreamRetries, ShouldEqual, 2)
+				So(cfg.UpstreamRetryBackoff, ShouldEqual, 100*time.Millisecond)
+				So(cfg.CircuitBreakerThreshold, ShouldEqual, 5)
+				So(cfg.CircuitBreakerOpenTimeout, ShouldEqual, 30*time.Second)
+				So(cfg.CacheSize, ShouldEqual, 1000)
+				So(cfg.CacheTTL, ShouldEqual, time.Minute)
+				So(cfg.CacheLoadTimeout, ShouldEqual, 15*time.Second)
+				So(cfg.ReleaseTimes, ShouldResemble, ReleaseTimes{7 * time.Hour, 9*time.Hour + 30*time.Minute})
+				So(cfg.StaleContentSize, ShouldEqual, 1000)
+				So(cfg.StaleContentMaxAge, ShouldEqual, time.Hour)
+				So(cfg.CacheControlMaxAge, ShouldEqual, 5*time.Minute)
+				So(cfg.CacheControlSharedMaxAge, ShouldEqual, 15*time.Minute)
+				So(cfg.SocialImageURL, ShouldBeEmpty)
+				So(cfg.OTExporterOTLPEndpoint, ShouldBeEmpty)
+				So(cfg.OTServiceName, ShouldEqual, "dp-frontend-articles-controller")
+				So(cfg.OTSamplingRatio, ShouldEqual, 1)
+				So(cfg.Now(), ShouldHappenWithin, time.Second, time.Now())
 			})
 
 			Convey("Then a second call to config should return the same config", func() {
@@ -37,4 +60,21 @@ func TestConfig(t *testing.T) {
 			})
 		})
 	})
+
+	Convey("Given a config with a fixed clock", t, func() {
+		fixed := time.Date(2022, 8, 12, 7, 0, 0, 0, time.UTC)
+		cfg := Config{Clock: func() time.Time { return fixed }}
+
+		Convey("Then the current time is the time of the clock", func() {
+			So(cfg.Now(), ShouldEqual, fixed)
+		})
+	})
+
+	Convey("Given a config without a clock", t, func() {
+		cfg := Config{}
+
+		Convey("Then the current time is the time of the system clock", func() {
+			So(cfg.Now(), ShouldHappenWithin, time.Second, time.Now())
+		})
+	})
 }
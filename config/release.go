@@ -0,0 +1,71 @@
+package config
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	// The release times are in UK time, so the time zone database is embedded for containers that do not have one
+	_ "time/tzdata"
+)
+
+// ReleaseTimes are the times of day, in UK time, that content is released at, as offsets from midnight. They are
+// given as a comma separated list of 24-hour times, e.g. '07:00,09:30'.
+type ReleaseTimes []time.Duration
+
+// releaseLocation is the time zone of the release calendar
+var releaseLocation = loadReleaseLocation()
+
+func loadReleaseLocation() *time.Location {
+	loc, err := time.LoadLocation("Europe/London")
+	if err != nil {
+		return time.UTC
+	}
+	return loc
+}
+
+// UnmarshalText parses a comma separated list of release times
+func (r *ReleaseTimes) UnmarshalText(text []byte) error {
+	times := ReleaseTimes{}
+	for _, s := range strings.Split(string(text), ",") {
+		s = strings.TrimSpace(s)
+		if s == "" {
+			continue
+		}
+		t, err := time.Parse("15:04", s)
+		if err != nil {
+			return fmt.Errorf("invalid release time %q: %w", s, err)
+		}
+		times = append(times, time.Duration(t.Hour())*time.Hour+time.Duration(t.Minute())*time.Minute)
+	}
+	sort.Slice(times, func(i, j int) bool { return times[i] < times[j] })
+
+	*r = times
+	return nil
+}
+
+// MarshalText formats the release times as they are configured, so that they are logged in the same format
+func (r ReleaseTimes) MarshalText() ([]byte, error) {
+	times := make([]string, len(r))
+	for i, offset := range r {
+		times[i] = fmt.Sprintf("%02d:%02d", int(offset/time.Hour), int(offset%time.Hour/time.Minute))
+	}
+	return []byte(strings.Join(times, ",")), nil
+}
+
+// Next returns the first release time after t, or the zero time if there are no release times
+func (r ReleaseTimes) Next(t time.Time) time.Time {
+	local := t.In(releaseLocation)
+	for day := 0; day <= 1; day++ {
+		for _, offset := range r {
+			// The release is built from the hour and minute, rather than added to midnight, so that it is at the
+			// same time of day on days that the clocks change
+			release := time.Date(local.Year(), local.Month(), local.Day()+day, int(offset/time.Hour), int(offset%time.Hour/time.Minute), 0, 0, releaseLocation)
+			if release.After(t) {
+				return release
+			}
+		}
+	}
+	return time.Time{}
+}
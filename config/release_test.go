@@ -0,0 +1,75 @@
+package config
+
+import (
+	"encoding/json"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/kelseyhightower/envconfig"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestReleaseTimes(t *testing.T) {
+	Convey("Given release times set by an environment variable", t, func() {
+		os.Clearenv()
+		os.Setenv("RELEASE_TIMES", "09:30, 07:00")
+		defer os.Clearenv()
+
+		var cfg struct {
+			ReleaseTimes ReleaseTimes `envconfig:"RELEASE_TIMES"`
+		}
+		err := envconfig.Process("", &cfg)
+
+		Convey("Then they are parsed in order", func() {
+			So(err, ShouldBeNil)
+			So(cfg.ReleaseTimes, ShouldResemble, ReleaseTimes{7 * time.Hour, 9*time.Hour + 30*time.Minute})
+		})
+
+		Convey("Then they are logged as they are configured", func() {
+			b, err := json.Marshal(cfg.ReleaseTimes)
+			So(err, ShouldBeNil)
+			So(string(b), ShouldEqual, `"07:00,09:30"`)
+		})
+	})
+
+	Convey("Given an invalid release time", t, func() {
+		var times ReleaseTimes
+		err := times.UnmarshalText([]byte("07:00,9.30am"))
+
+		Convey("Then an error is returned", func() {
+			So(err, ShouldNotBeNil)
+		})
+	})
+
+	Convey("Given no release times", t, func() {
+		var times ReleaseTimes
+		So(times.UnmarshalText([]byte("")), ShouldBeNil)
+
+		Convey("Then there is no next release", func() {
+			So(times, ShouldBeEmpty)
+			So(times.Next(time.Now()).IsZero(), ShouldBeTrue)
+		})
+	})
+
+	Convey("Given release times at 07:00 and 09:30", t, func() {
+		times := ReleaseTimes{7 * time.Hour, 9*time.Hour + 30*time.Minute}
+
+		Convey("Then the next release in summer is in British Summer Time", func() {
+			So(times.Next(time.Date(2022, 8, 12, 5, 0, 0, 0, time.UTC)), ShouldEqual, time.Date(2022, 8, 12, 6, 0, 0, 0, time.UTC))
+			So(times.Next(time.Date(2022, 8, 12, 6, 0, 0, 0, time.UTC)), ShouldEqual, time.Date(2022, 8, 12, 8, 30, 0, 0, time.UTC))
+		})
+
+		Convey("Then the next release in winter is in Greenwich Mean Time", func() {
+			So(times.Next(time.Date(2022, 12, 12, 8, 0, 0, 0, time.UTC)), ShouldEqual, time.Date(2022, 12, 12, 9, 30, 0, 0, time.UTC))
+		})
+
+		Convey("Then the next release after the last of the day is the first of the next day", func() {
+			So(times.Next(time.Date(2022, 8, 12, 9, 0, 0, 0, time.UTC)), ShouldEqual, time.Date(2022, 8, 13, 6, 0, 0, 0, time.UTC))
+		})
+
+		Convey("Then the next release is at the same time of day when the clocks change", func() {
+			So(times.Next(time.Date(2022, 10, 29, 12, 0, 0, 0, time.UTC)), ShouldEqual, time.Date(2022, 10, 30, 7, 0, 0, 0, time.UTC))
+		})
+	})
+}
@@ -0,0 +1,19 @@
+Feature: Admin
+
+  Scenario: Cached content is invalidated through the admin listener
+    When I POST "/cache/invalidate" to the admin listener with the body:
+      """
+      {"uri_prefix": "/economy"}
+      """
+    Then the HTTP status code should be "200"
+    And I should receive the following JSON response:
+      """
+      {"evicted": 0}
+      """
+
+  Scenario: Cached content cannot be invalidated through the public listener
+    When I POST "/cache/invalidate" with the body:
+      """
+      {"uri_prefix": "/economy"}
+      """
+    Then the HTTP status code should be "405"
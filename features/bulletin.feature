@@ -0,0 +1,40 @@
+Feature: Bulletin
+
+  Scenario: A bulletin is rendered from the Articles API
+    Given the upstream services respond as recorded in "gdp-bulletin.json"
+    When I GET "/economy/grossdomesticproductgdp/bulletins/gdpmonthlyestimateuk/june2022"
+    Then the HTTP status code should be "200"
+    And the response header "Content-Type" should be "text/html; charset=UTF-8"
+    And the response body should contain "GDP monthly estimate, UK"
+    And the response body should contain "GDP fell by 0.6%."
+    And the response body should contain "Planned maintenance on Saturday"
+    And the response body should contain "application/ld+json"
+    And the response body should contain "og:title"
+
+  Scenario: A bulletin is rendered without the homepage content when it is unavailable
+    Given the upstream services respond as recorded in "gdp-bulletin.json"
+    And the upstream services respond to "GET /data?uri=/" with status 500
+    When I GET "/economy/grossdomesticproductgdp/bulletins/gdpmonthlyestimateuk/june2022"
+    Then the HTTP status code should be "200"
+    And the response body should contain "GDP monthly estimate, UK"
+
+  Scenario: A bulletin that does not exist is not found
+    When I GET "/economy/grossdomesticproductgdp/bulletins/gdpmonthlyestimateuk/june1922"
+    Then the HTTP status code should be "404"
+
+  Scenario: A bulletin cannot be rendered when the Articles API is unavailable
+    Given the upstream services respond to "GET /articles/legacy" with status 503
+    When I GET "/economy/grossdomesticproductgdp/bulletins/gdpmonthlyestimateuk/june2022"
+    Then the HTTP status code should be "503"
+    And the response header "Retry-After" should be "30"
+
+  Scenario: A bulletin is rendered from stale content when the Articles API is unavailable
+    Given the upstream services respond as recorded in "gdp-bulletin.json"
+    And I GET "/economy/grossdomesticproductgdp/bulletins/gdpmonthlyestimateuk/june2022"
+    When the upstream services respond to "GET /articles/legacy" with status 503
+    And I GET "/economy/grossdomesticproductgdp/bulletins/gdpmonthlyestimateuk/june2022"
+    Then the HTTP status code should be "200"
+    And the response header "Warning" should contain "Response is Stale"
+    And the response header "Age" should be a number of seconds
+    And the response header "Cache-Control" should be "no-store"
+    And the response body should contain "GDP monthly estimate, UK"
@@ -0,0 +1,51 @@
+Feature: Bulletin data
+
+  Scenario: The content of a bulletin is returned as it is by the Articles API when no version is requested
+    Given the upstream services respond as recorded in "gdp-bulletin.json"
+    When I GET "/economy/grossdomesticproductgdp/bulletins/gdpmonthlyestimateuk/june2022/data"
+    Then the HTTP status code should be "200"
+    And the response header "Content-Type" should be "application/json"
+    And the response body should contain "GDP monthly estimate, UK"
+
+  Scenario: Version 1 of the content of a bulletin is returned as JSON
+    Given the upstream services respond as recorded in "gdp-bulletin.json"
+    And I set the "Accept" header to "application/json; version=1"
+    When I GET "/economy/grossdomesticproductgdp/bulletins/gdpmonthlyestimateuk/june2022/data"
+    Then the HTTP status code should be "200"
+    And the response header "Content-Type" should be "application/json; version=1"
+    And I should receive the following JSON response:
+      """
+      {
+        "uri": "/economy/grossdomesticproductgdp/bulletins/gdpmonthlyestimateuk/june2022",
+        "type": "bulletin",
+        "title": "GDP monthly estimate, UK",
+        "edition": "June 2022",
+        "summary": "Monthly gross domestic product (GDP) estimates.",
+        "metaDescription": "Monthly GDP estimates for the UK.",
+        "keywords": ["gdp"],
+        "releaseDate": "2022-08-12T06:00:00.000Z",
+        "nextReleaseDate": "12 September 2022",
+        "nationalStatistic": true,
+        "welshStatistic": false,
+        "latestRelease": true,
+        "latestReleaseUri": "/economy/grossdomesticproductgdp/bulletins/gdpmonthlyestimateuk/latest",
+        "contact": {"name": "GDP team", "email": "gdp@ons.gov.uk", "telephone": "+44 1633 456721"},
+        "sections": [{"title": "Main points", "markdown": "GDP fell by 0.6%."}],
+        "accordion": [],
+        "charts": [],
+        "tables": [],
+        "images": [],
+        "equations": [],
+        "relatedBulletins": [],
+        "relatedData": [],
+        "links": [],
+        "versions": [],
+        "alerts": []
+      }
+      """
+
+  Scenario: An unsupported version of the data is not acceptable
+    Given the upstream services respond as recorded in "gdp-bulletin.json"
+    And I set the "Accept" header to "application/json; version=99"
+    When I GET "/economy/grossdomesticproductgdp/bulletins/gdpmonthlyestimateuk/june2022/data"
+    Then the HTTP status code should be "406"
@@ -0,0 +1,7 @@
+Feature: Health
+
+  Scenario: The health of the service is reported
+    When I GET "/health"
+    Then the HTTP status code should be "200"
+    And the response body should contain "Zebedee"
+    And the response body should contain "Articles API"
@@ -0,0 +1,12 @@
+Feature: Sixteens bulletin
+
+  Scenario: A bulletin is rendered with the sixteens template
+    Given the upstream services respond as recorded in "gdp-bulletin.json"
+    When I GET "/sixteens/economy/grossdomesticproductgdp/bulletins/gdpmonthlyestimateuk/june2022"
+    Then the HTTP status code should be "200"
+    And the response body should contain "GDP monthly estimate, UK"
+
+  Scenario: A bulletin that cannot be mapped is a bad gateway
+    Given the upstream services respond as recorded in "untitled-bulletin.json"
+    When I GET "/sixteens/economy/grossdomesticproductgdp/bulletins/gdpmonthlyestimateuk/june2022"
+    Then the HTTP status code should be "502"
@@ -0,0 +1,142 @@
+package steps
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"github.com/ONSdigital/dp-api-clients-go/v2/health"
+	"github.com/ONSdigital/dp-api-clients-go/v2/renderer"
+	"github.com/ONSdigital/dp-frontend-articles-controller/config"
+	"github.com/ONSdigital/dp-frontend-articles-controller/service"
+)
+
+// startupTimeout is how long the service has to check its dependencies when it is started
+const startupTimeout = 5 * time.Second
+
+// Component boots the real service against an UpstreamStub, so that scenarios can make HTTP requests to it
+type Component struct {
+	Stub *UpstreamStub
+
+	cfg      config.Config
+	svc      *service.Service
+	server   *httptest.Server
+	admin    *httptest.Server
+	response *http.Response
+	body     []byte
+	headers  http.Header
+}
+
+// NewComponent creates a component with the default config, pointed at a new upstream stub
+func NewComponent() (*Component, error) {
+	cfg, err := config.Get()
+	if err != nil {
+		return nil, err
+	}
+
+	c := &Component{
+		Stub: NewUpstreamStub(),
+		cfg:  *cfg,
+	}
+	c.cfg.APIRouterURL = c.Stub.Server.URL
+	c.cfg.PDFServiceURL = c.Stub.Server.URL
+	// Each scenario sets up its own upstream responses, which must not be hidden by responses cached by another
+	c.cfg.CacheSize = 0
+	c.cfg.HealthCheckInterval = 100 * time.Millisecond
+	c.cfg.HealthCheckCriticalTimeout = time.Second
+	return c, nil
+}
+
+// Start initialises and runs the service
+func (c *Component) Start(ctx context.Context) error {
+	c.headers = make(http.Header)
+	c.response = nil
+	c.body = nil
+
+	c.svc = service.New()
+	cfg := c.cfg
+	if err := c.svc.Init(ctx, &cfg, service.NewServiceList(&initialiser{component: c})); err != nil {
+		return err
+	}
+	c.svc.Run(ctx, make(chan error, 1))
+	return c.waitForStartup()
+}
+
+// waitForStartup waits until the dependencies of the service have been checked, as the service reports that it is
+// still starting up until then
+func (c *Component) waitForStartup() error {
+	deadline := time.Now().Add(startupTimeout)
+	for time.Now().Before(deadline) {
+		resp, err := c.server.Client().Get(c.server.URL + "/health")
+		if err != nil {
+			return err
+		}
+		resp.Body.Close()
+		if resp.StatusCode != http.StatusTooManyRequests {
+			return nil
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	return fmt.Errorf("service did not start within %s", startupTimeout)
+}
+
+// Stop closes the service and resets the stub for the next scenario. The service is only closed once, as the hooks
+// after a scenario can be called again when it fails.
+func (c *Component) Stop(ctx context.Context) error {
+	defer c.Stub.Reset()
+	if c.svc == nil {
+		return nil
+	}
+
+	svc := c.svc
+	c.svc = nil
+	return svc.Close(ctx)
+}
+
+// Close stops the stub
+func (c *Component) Close() {
+	c.Stub.Close()
+}
+
+// initialiser initialises the service with an in-process HTTP server, and clients for the upstream stub
+type initialiser struct {
+	component *Component
+}
+
+func (i *initialiser) DoGetHTTPServer(bindAddr string, router http.Handler) service.HTTPServer {
+	server := httptest.NewServer(router)
+	if bindAddr == i.component.cfg.AdminBindAddr {
+		i.component.admin = server
+	} else {
+		i.component.server = server
+	}
+	return &testServer{server: server}
+}
+
+func (i *initialiser) DoGetHealthClient(name, url string) *health.Client {
+	return health.NewClient(name, url)
+}
+
+func (i *initialiser) DoGetHealthCheck(cfg *config.Config, buildTime, gitCommit, version string) (service.HealthChecker, error) {
+	return (&service.Init{}).DoGetHealthCheck(cfg, "1", "component", "v0.0.0")
+}
+
+func (i *initialiser) DoGetRendererClient(rendererURL string) *renderer.Renderer {
+	return renderer.New(rendererURL)
+}
+
+// testServer is an HTTP server that is already listening when the service runs it
+type testServer struct {
+	server *httptest.Server
+}
+
+func (s *testServer) ListenAndServe() error {
+	return nil
+}
+
+func (s *testServer) Shutdown(ctx context.Context) error {
+	s.server.Close()
+	return nil
+}
@@ -0,0 +1,163 @@
+package steps
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/cucumber/godog"
+)
+
+// FixturesPath is the directory that fixtures of upstream responses are read from
+var FixturesPath = filepath.Join("features", "fixtures")
+
+// RegisterSteps registers the steps of the component with a scenario, starting the service before the scenario and
+// stopping it afterwards
+func (c *Component) RegisterSteps(ctx *godog.ScenarioContext) {
+	ctx.Before(func(ctx context.Context, sc *godog.Scenario) (context.Context, error) {
+		return ctx, c.Start(ctx)
+	})
+	ctx.After(func(ctx context.Context, sc *godog.Scenario, err error) (context.Context, error) {
+		return ctx, c.Stop(ctx)
+	})
+
+	ctx.Step(`^the upstream services respond as recorded in "([^"]*)"$`, c.theUpstreamServicesRespondAsRecordedIn)
+	ctx.Step(`^the upstream services respond to "([^"]*) ([^"]*)" with status (\d+)$`, c.theUpstreamServicesRespondWithStatus)
+	ctx.Step(`^I set the "([^"]*)" header to "([^"]*)"$`, c.iSetTheHeaderTo)
+	ctx.Step(`^I GET "([^"]*)"$`, c.iGET)
+	ctx.Step(`^I POST "([^"]*)" with the body:$`, c.iPOSTWithTheBody)
+	ctx.Step(`^I POST "([^"]*)" to the admin listener with the body:$`, c.iPOSTToTheAdminListenerWithTheBody)
+	ctx.Step(`^the HTTP status code should be "(\d+)"$`, c.theHTTPStatusCodeShouldBe)
+	ctx.Step(`^the response header "([^"]*)" should be "([^"]*)"$`, c.theResponseHeaderShouldBe)
+	ctx.Step(`^the response header "([^"]*)" should contain "([^"]*)"$`, c.theResponseHeaderShouldContain)
+	ctx.Step(`^the response header "([^"]*)" should be a number of seconds$`, c.theResponseHeaderShouldBeANumberOfSeconds)
+	ctx.Step(`^the response body should contain "([^"]*)"$`, c.theResponseBodyShouldContain)
+	ctx.Step(`^I should receive the following JSON response:$`, c.iShouldReceiveTheFollowingJSONResponse)
+}
+
+func (c *Component) theUpstreamServicesRespondAsRecordedIn(fixture string) error {
+	return c.Stub.Load(filepath.Join(FixturesPath, fixture))
+}
+
+func (c *Component) theUpstreamServicesRespondWithStatus(method, path string, status int) error {
+	request := ExchangeRequest{Method: method, Path: path}
+	if i := strings.Index(path, "?"); i >= 0 {
+		request.Path = path[:i]
+		request.Query = make(map[string]string)
+		for _, param := range strings.Split(path[i+1:], "&") {
+			key, value := param, ""
+			if j := strings.Index(param, "="); j >= 0 {
+				key, value = param[:j], param[j+1:]
+			}
+			request.Query[key] = value
+		}
+	}
+
+	c.Stub.Add(Exchange{
+		Request:  request,
+		Response: ExchangeResponse{Status: status, Body: json.RawMessage(`{}`)},
+	})
+	return nil
+}
+
+func (c *Component) iSetTheHeaderTo(name, value string) error {
+	c.headers.Set(name, value)
+	return nil
+}
+
+func (c *Component) iGET(path string) error {
+	return c.do(c.server, http.MethodGet, path, "")
+}
+
+func (c *Component) iPOSTWithTheBody(path string, body *godog.DocString) error {
+	return c.do(c.server, http.MethodPost, path, body.Content)
+}
+
+func (c *Component) iPOSTToTheAdminListenerWithTheBody(path string, body *godog.DocString) error {
+	if c.admin == nil {
+		return fmt.Errorf("the admin listener is not running")
+	}
+	return c.do(c.admin, http.MethodPost, path, body.Content)
+}
+
+// do makes a request to one of the listeners of the service, keeping the response for the steps that check it
+func (c *Component) do(server *httptest.Server, method, path, body string) error {
+	req, err := http.NewRequest(method, server.URL+path, strings.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header = c.headers.Clone()
+
+	resp, err := server.Client().Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	c.response = resp
+	c.body, err = io.ReadAll(resp.Body)
+	return err
+}
+
+func (c *Component) theHTTPStatusCodeShouldBe(status int) error {
+	if c.response.StatusCode != status {
+		return fmt.Errorf("expected status %d, got %d with body: %s", status, c.response.StatusCode, c.body)
+	}
+	return nil
+}
+
+func (c *Component) theResponseHeaderShouldBe(name, value string) error {
+	if actual := c.response.Header.Get(name); actual != value {
+		return fmt.Errorf("expected %s header %q, got %q", name, value, actual)
+	}
+	return nil
+}
+
+func (c *Component) theResponseHeaderShouldContain(name, text string) error {
+	if actual := c.response.Header.Get(name); !strings.Contains(actual, text) {
+		return fmt.Errorf("expected %s header to contain %q, got %q", name, text, actual)
+	}
+	return nil
+}
+
+// theResponseHeaderShouldBeANumberOfSeconds checks that a header such as Age is a non-negative integer, for headers
+// whose exact value depends on how long the scenario took to run
+func (c *Component) theResponseHeaderShouldBeANumberOfSeconds(name string) error {
+	values := c.response.Header.Values(name)
+	if len(values) != 1 {
+		return fmt.Errorf("expected a single %s header, got %q", name, values)
+	}
+	if _, err := strconv.ParseUint(values[0], 10, 63); err != nil {
+		return fmt.Errorf("expected %s header to be a non-negative number of seconds, got %q", name, values[0])
+	}
+	return nil
+}
+
+func (c *Component) theResponseBodyShouldContain(text string) error {
+	if !strings.Contains(string(c.body), text) {
+		return fmt.Errorf("expected the response body to contain %q", text)
+	}
+	return nil
+}
+
+func (c *Component) iShouldReceiveTheFollowingJSONResponse(expected *godog.DocString) error {
+	var expectedJSON, actualJSON interface{}
+	if err := json.Unmarshal([]byte(expected.Content), &expectedJSON); err != nil {
+		return fmt.Errorf("failed to decode the expected response: %w", err)
+	}
+	if err := json.Unmarshal(c.body, &actualJSON); err != nil {
+		return fmt.Errorf("failed to decode the response: %w: %s", err, c.body)
+	}
+
+	if !reflect.DeepEqual(expectedJSON, actualJSON) {
+		return fmt.Errorf("expected the JSON response:\n%s\ngot:\n%s", expected.Content, c.body)
+	}
+	return nil
+}
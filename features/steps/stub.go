@@ -0,0 +1,135 @@
+package steps
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"sync"
+)
+
+// Exchange is a request to an upstream service and the response it gets. Fixtures are JSON arrays of exchanges,
+// so that responses recorded from a real environment can be replayed.
+type Exchange struct {
+	Request  ExchangeRequest  `json:"request"`
+	Response ExchangeResponse `json:"response"`
+}
+
+// ExchangeRequest describes the requests that an exchange responds to. Query parameters that are not listed are
+// not matched on.
+type ExchangeRequest struct {
+	Method string            `json:"method"`
+	Path   string            `json:"path"`
+	Query  map[string]string `json:"query"`
+}
+
+// ExchangeResponse is the response of an exchange. The body is written as JSON.
+type ExchangeResponse struct {
+	Status  int               `json:"status"`
+	Headers map[string]string `json:"headers"`
+	Body    json.RawMessage   `json:"body"`
+}
+
+func (r ExchangeRequest) matches(req *http.Request) bool {
+	method := r.Method
+	if method == "" {
+		method = http.MethodGet
+	}
+	if req.Method != method || req.URL.Path != r.Path {
+		return false
+	}
+
+	query := req.URL.Query()
+	for key, value := range r.Query {
+		if query.Get(key) != value {
+			return false
+		}
+	}
+	return true
+}
+
+// UpstreamStub stands in for the API router, serving the exchanges it has been given for Zebedee and the Articles
+// API. Requests that do not match an exchange get a 404, as they would for content that does not exist.
+type UpstreamStub struct {
+	Server *httptest.Server
+
+	mu        sync.Mutex
+	exchanges []Exchange
+}
+
+// NewUpstreamStub starts a stub, which is healthy until it is given another response for /health
+func NewUpstreamStub() *UpstreamStub {
+	s := &UpstreamStub{}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+	s.Reset()
+	return s
+}
+
+// Reset removes the exchanges of a scenario
+func (s *UpstreamStub) Reset() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.exchanges = []Exchange{{
+		Request:  ExchangeRequest{Path: "/health"},
+		Response: ExchangeResponse{Status: http.StatusOK, Body: json.RawMessage(`{"status":"OK"}`)},
+	}}
+}
+
+// Add adds exchanges to the stub. Later exchanges take precedence over earlier ones for the same request.
+func (s *UpstreamStub) Add(exchanges ...Exchange) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.exchanges = append(s.exchanges, exchanges...)
+}
+
+// Load adds the exchanges in a fixture file to the stub
+func (s *UpstreamStub) Load(filename string) error {
+	data, err := os.ReadFile(filename)
+	if err != nil {
+		return err
+	}
+
+	var exchanges []Exchange
+	if err = json.Unmarshal(data, &exchanges); err != nil {
+		return fmt.Errorf("failed to decode fixture %s: %w", filename, err)
+	}
+
+	s.Add(exchanges...)
+	return nil
+}
+
+// Close stops the stub
+func (s *UpstreamStub) Close() {
+	s.Server.Close()
+}
+
+func (s *UpstreamStub) serveHTTP(w http.ResponseWriter, req *http.Request) {
+	s.mu.Lock()
+	var response *ExchangeResponse
+	for i := len(s.exchanges) - 1; i >= 0; i-- {
+		if s.exchanges[i].Request.matches(req) {
+			response = &s.exchanges[i].Response
+			break
+		}
+	}
+	s.mu.Unlock()
+
+	if response == nil {
+		http.NotFound(w, req)
+		return
+	}
+
+	for key, value := range response.Headers {
+		w.Header().Set(key, value)
+	}
+	if w.Header().Get("Content-Type") == "" {
+		w.Header().Set("Content-Type", "application/json")
+	}
+	status := response.Status
+	if status == 0 {
+		status = http.StatusOK
+	}
+	w.WriteHeader(status)
+	w.Write(response.Body)
+}
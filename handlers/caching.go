@@ -0,0 +1,122 @@
+package handlers
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/ONSdigital/dp-frontend-articles-controller/config"
+	"github.com/ONSdigital/log.go/v2/log"
+)
+
+// varyHeaders are the request headers that a response depends on besides its URL. The language, collection and access
+// token are read from cookies, and the collection and access token of a preview can also be sent as headers.
+var varyHeaders = []string{"Cookie", "Collection-Id", "X-Florence-Token"}
+
+// bufferedResponseWriter holds on to a response so that headers can be set from its body before it is written
+type bufferedResponseWriter struct {
+	header http.Header
+	status int
+	body   bytes.Buffer
+}
+
+func newBufferedResponseWriter() *bufferedResponseWriter {
+	return &bufferedResponseWriter{header: make(http.Header)}
+}
+
+func (b *bufferedResponseWriter) Header() http.Header { return b.header }
+
+func (b *bufferedResponseWriter) Write(p []byte) (int, error) {
+	if b.status == 0 {
+		b.status = http.StatusOK
+	}
+	return b.body.Write(p)
+}
+
+// WriteHeader keeps the first status written, as the renderer writes a 200 after any error status set by a handler
+func (b *bufferedResponseWriter) WriteHeader(status int) {
+	if b.status == 0 {
+		b.status = status
+	}
+}
+
+// withCacheHeaders writes the response from handle with caching headers. Successful responses for published
+// content can be cached by the CDN, and are given an ETag from their body so that If-None-Match requests can be
+// answered with a 304. Previews of content in a collection must never be cached. Every response varies on the
+// varyHeaders, so that a shared cache does not serve a page in another language, or a preview, in place of it.
+func withCacheHeaders(w http.ResponseWriter, req *http.Request, cfg config.Config, collectionID, accessToken string, handle func(w http.ResponseWriter)) {
+	buf := newBufferedResponseWriter()
+	handle(buf)
+
+	for name, values := range buf.header {
+		w.Header()[name] = values
+	}
+	w.Header().Add("Vary", strings.Join(varyHeaders, ", "))
+	status := buf.status
+	if status == 0 {
+		status = http.StatusOK
+	}
+
+	switch {
+	case collectionID != "" || accessToken != "":
+		w.Header().Set("Cache-Control", "private, no-store")
+	case status != http.StatusOK:
+		w.Header().Set("Cache-Control", "no-store")
+	default:
+		etag := createETag(buf.body.Bytes())
+		w.Header().Set("ETag", etag)
+		maxAge, sharedMaxAge := getMaxAges(cfg)
+		w.Header().Set("Cache-Control", fmt.Sprintf("public, max-age=%d, s-maxage=%d", int(maxAge.Seconds()), int(sharedMaxAge.Seconds())))
+
+		if matchesETag(req.Header.Get("If-None-Match"), etag) {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+	}
+
+	w.WriteHeader(status)
+	if _, err := w.Write(buf.body.Bytes()); err != nil {
+		log.Error(req.Context(), "failed to write response", err)
+	}
+}
+
+// getMaxAges returns the max-age and s-maxage of a published page, which are capped at the time until the next release
+// so that neither browsers nor the CDN keep a page once a release may have replaced its latest content
+func getMaxAges(cfg config.Config) (maxAge, sharedMaxAge time.Duration) {
+	maxAge, sharedMaxAge = cfg.CacheControlMaxAge, cfg.CacheControlSharedMaxAge
+
+	now := cfg.Now()
+	next := cfg.ReleaseTimes.Next(now)
+	if next.IsZero() {
+		return maxAge, sharedMaxAge
+	}
+	untilRelease := next.Sub(now)
+	if maxAge > untilRelease {
+		maxAge = untilRelease
+	}
+	if sharedMaxAge > untilRelease {
+		sharedMaxAge = untilRelease
+	}
+	return maxAge, sharedMaxAge
+}
+
+func createETag(body []byte) string {
+	sum := sha256.Sum256(body)
+	return `"` + hex.EncodeToString(sum[:16]) + `"`
+}
+
+// matchesETag reports whether an If-None-Match header matches etag, using the weak comparison that the header
+// requires
+func matchesETag(ifNoneMatch, etag string) bool {
+	for _, candidate := range strings.Split(ifNoneMatch, ",") {
+		candidate = strings.TrimSpace(candidate)
+		if candidate == "*" || strings.TrimPrefix(candidate, "W/") == etag {
+			return true
+		}
+	}
+	return false
+}
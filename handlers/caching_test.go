@@ -0,0 +1,117 @@
+package handlers
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/ONSdigital/dp-frontend-articles-controller/config"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestUnitCaching(t *testing.T) {
+	Convey("test withCacheHeaders", t, func() {
+		cfg := config.Config{
+			CacheControlMaxAge:       5 * time.Minute,
+			CacheControlSharedMaxAge: 15 * time.Minute,
+		}
+		body := "<html>a bulletin</html>"
+		render := func(w http.ResponseWriter) {
+			w.Header().Set("Content-Type", "text/html")
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(body))
+		}
+		etag := createETag([]byte(body))
+
+		Convey("it sets an ETag and public Cache-Control on published content", func() {
+			req := httptest.NewRequest("GET", "http://localhost:26500/a/bulletin", nil)
+			w := httptest.NewRecorder()
+
+			withCacheHeaders(w, req, cfg, "", "", render)
+
+			So(w.Code, ShouldEqual, http.StatusOK)
+			So(w.Body.String(), ShouldEqual, body)
+			So(w.Header().Get("Content-Type"), ShouldEqual, "text/html")
+			So(w.Header().Get("ETag"), ShouldEqual, etag)
+			So(w.Header().Get("Cache-Control"), ShouldEqual, "public, max-age=300, s-maxage=900")
+		})
+
+		Convey("it varies on the cookies and headers that select the language and collection", func() {
+			req := httptest.NewRequest("GET", "http://localhost:26500/a/bulletin", nil)
+			w := httptest.NewRecorder()
+
+			withCacheHeaders(w, req, cfg, "", "", func(w http.ResponseWriter) {
+				w.Header().Set("Vary", "Accept")
+				render(w)
+			})
+
+			So(w.Header().Values("Vary"), ShouldResemble, []string{"Accept", "Cookie, Collection-Id, X-Florence-Token"})
+		})
+
+		Convey("it caps the max ages at the time until the next release", func() {
+			cfg.ReleaseTimes = config.ReleaseTimes{9*time.Hour + 30*time.Minute}
+			cfg.Clock = func() time.Time { return time.Date(2022, 8, 12, 8, 20, 0, 0, time.UTC) }
+			req := httptest.NewRequest("GET", "http://localhost:26500/a/bulletin", nil)
+			w := httptest.NewRecorder()
+
+			withCacheHeaders(w, req, cfg, "", "", render)
+
+			So(w.Header().Get("Cache-Control"), ShouldEqual, "public, max-age=300, s-maxage=600")
+		})
+
+		Convey("it answers a matching If-None-Match with 304", func() {
+			for _, ifNoneMatch := range []string{etag, "W/" + etag, `"another", ` + etag, "*"} {
+				req := httptest.NewRequest("GET", "http://localhost:26500/a/bulletin", nil)
+				req.Header.Set("If-None-Match", ifNoneMatch)
+				w := httptest.NewRecorder()
+
+				withCacheHeaders(w, req, cfg, "", "", render)
+
+				So(w.Code, ShouldEqual, http.StatusNotModified)
+				So(w.Body.Len(), ShouldEqual, 0)
+				So(w.Header().Get("ETag"), ShouldEqual, etag)
+			}
+		})
+
+		Convey("it returns the page when If-None-Match does not match", func() {
+			req := httptest.NewRequest("GET", "http://localhost:26500/a/bulletin", nil)
+			req.Header.Set("If-None-Match", `"an old etag"`)
+			w := httptest.NewRecorder()
+
+			withCacheHeaders(w, req, cfg, "", "", render)
+
+			So(w.Code, ShouldEqual, http.StatusOK)
+			So(w.Body.String(), ShouldEqual, body)
+		})
+
+		Convey("it marks previews of content in a collection as private and uncacheable", func() {
+			req := httptest.NewRequest("GET", "http://localhost:26500/a/bulletin", nil)
+			req.Header.Set("If-None-Match", etag)
+			w := httptest.NewRecorder()
+
+			withCacheHeaders(w, req, cfg, collectionID, accessToken, render)
+
+			So(w.Code, ShouldEqual, http.StatusOK)
+			So(w.Body.String(), ShouldEqual, body)
+			So(w.Header().Get("ETag"), ShouldBeEmpty)
+			So(w.Header().Get("Cache-Control"), ShouldEqual, "private, no-store")
+		})
+
+		Convey("it keeps the status of an error and does not allow it to be cached", func() {
+			req := httptest.NewRequest("GET", "http://localhost:26500/a/bulletin", nil)
+			w := httptest.NewRecorder()
+
+			withCacheHeaders(w, req, cfg, "", "", func(w http.ResponseWriter) {
+				w.WriteHeader(http.StatusNotFound)
+				w.WriteHeader(http.StatusOK)
+				w.Write([]byte("not found"))
+			})
+
+			So(w.Code, ShouldEqual, http.StatusNotFound)
+			So(w.Body.String(), ShouldEqual, "not found")
+			So(w.Header().Get("ETag"), ShouldBeEmpty)
+			So(w.Header().Get("Cache-Control"), ShouldEqual, "no-store")
+		})
+	})
+}
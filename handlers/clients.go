@@ -6,6 +6,7 @@ import (
 
 	"github.com/ONSdigital/dp-api-clients-go/v2/articles"
 	"github.com/ONSdigital/dp-api-clients-go/v2/zebedee"
+	"github.com/ONSdigital/dp-frontend-articles-controller/pdf"
 	"github.com/ONSdigital/dp-renderer/model"
 )
 
@@ -28,9 +29,16 @@ type RenderClient interface {
 type ZebedeeClient interface {
 	GetBreadcrumb(ctx context.Context, userAccessToken, collectionID, lang, uri string) ([]zebedee.Breadcrumb, error)
 	GetHomepageContent(ctx context.Context, userAccessToken, collectionID, lang, path string) (m zebedee.HomepageContent, err error)
+	GetPageTitle(ctx context.Context, userAccessToken, collectionID, lang, uri string) (zebedee.PageTitle, error)
+	Get(ctx context.Context, userAccessToken, path string) ([]byte, error)
 }
 
 // ArticlesApiClient is an interface for the Articles API client
 type ArticlesApiClient interface {
 	GetLegacyBulletin(ctx context.Context, userAccessToken, collectionID, lang, uri string) (*articles.Bulletin, error)
 }
+
+// PDFClient is an interface for the client of the service that provides PDF versions of pages
+type PDFClient interface {
+	GetPDF(ctx context.Context, userAccessToken, collectionID, lang, uri string) (*pdf.PDF, error)
+}
@@ -0,0 +1,129 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"sync"
+
+	"github.com/ONSdigital/dp-api-clients-go/v2/articles"
+	"github.com/ONSdigital/dp-api-clients-go/v2/zebedee"
+	"github.com/ONSdigital/dp-frontend-articles-controller/config"
+	"github.com/ONSdigital/dp-frontend-articles-controller/mapper"
+	"github.com/ONSdigital/log.go/v2/log"
+)
+
+func compendiumLandingPage(w http.ResponseWriter, req *http.Request, userAccessToken, collectionID, lang string, landingPage articles.Bulletin, homepageContent zebedee.HomepageContent, rc RenderClient, zc ZebedeeClient, cfg config.Config) {
+	ctx := req.Context()
+
+	var breadcrumbs []zebedee.Breadcrumb
+	var compendium mapper.CompendiumLandingPage
+	f := newFetcher(ctx)
+	f.required("breadcrumb", serviceZebedee, func(ctx context.Context) (err error) {
+		breadcrumbs, err = zc.GetBreadcrumb(ctx, userAccessToken, collectionID, lang, landingPage.URI)
+		return err
+	})
+	f.required("compendium", serviceZebedee, func(ctx context.Context) (err error) {
+		compendium, err = getCompendium(ctx, zc, userAccessToken, collectionID, lang, landingPage.URI)
+		return err
+	})
+	if ferr := f.wait(); ferr != nil {
+		handleError(w, req, ferr.service, ferr.err, lang, homepageContent, rc)
+		return
+	}
+
+	basePage := rc.NewBasePageModel()
+	span := startMappingSpan(ctx, "CreateCompendiumLandingPageModel")
+	model, err := mapper.CreateCompendiumLandingPageModel(basePage, landingPage, compendium, breadcrumbs, lang, getRequestProtocol(req), homepageContent.ServiceMessage, homepageContent.EmergencyBanner, cfg.SocialImageURL)
+	span.End()
+	if err != nil {
+		handleError(w, req, mappingService(err), err, lang, homepageContent, rc)
+		return
+	}
+	rc.BuildPage(w, model, "compendium-landing-page")
+}
+
+func compendiumChapter(w http.ResponseWriter, req *http.Request, userAccessToken, collectionID, lang string, chapter articles.Bulletin, homepageContent zebedee.HomepageContent, rc RenderClient, zc ZebedeeClient, cfg config.Config) {
+	ctx := req.Context()
+
+	var breadcrumbs []zebedee.Breadcrumb
+	var compendium mapper.CompendiumLandingPage
+	f := newFetcher(ctx)
+	f.required("breadcrumb", serviceZebedee, func(ctx context.Context) (err error) {
+		breadcrumbs, err = zc.GetBreadcrumb(ctx, userAccessToken, collectionID, lang, chapter.URI)
+		return err
+	})
+	f.required("compendium", serviceZebedee, func(ctx context.Context) (err error) {
+		compendium, err = getCompendium(ctx, zc, userAccessToken, collectionID, lang, mapper.CompendiumLandingPageURI(chapter.URI))
+		return err
+	})
+	if ferr := f.wait(); ferr != nil {
+		handleError(w, req, ferr.service, ferr.err, lang, homepageContent, rc)
+		return
+	}
+
+	basePage := rc.NewBasePageModel()
+	span := startMappingSpan(ctx, "CreateCompendiumChapterModel")
+	model, err := mapper.CreateCompendiumChapterModel(basePage, chapter, compendium, breadcrumbs, lang, getRequestProtocol(req), homepageContent.ServiceMessage, homepageContent.EmergencyBanner, cfg.SocialImageURL)
+	span.End()
+	if err != nil {
+		handleError(w, req, mappingService(err), err, lang, homepageContent, rc)
+		return
+	}
+	if len(model.UnresolvedFigures) > 0 {
+		log.Warn(ctx, "unable to resolve figures referenced in compendium chapter markdown", log.Data{"uri": chapter.URI, "figures": model.UnresolvedFigures})
+	}
+	rc.BuildPage(w, model, "bulletin")
+}
+
+// maxConcurrentChapterTitles is the most requests for the titles of compendium chapters that are made at once
+const maxConcurrentChapterTitles = 5
+
+// getCompendium gets the compendium landing page from zebedee, as its chapters are not returned by the articles API
+func getCompendium(ctx context.Context, zc ZebedeeClient, userAccessToken, collectionID, lang, uri string) (mapper.CompendiumLandingPage, error) {
+	path := "/data"
+	if collectionID != "" {
+		path += "/" + url.PathEscape(collectionID)
+	}
+	query := url.Values{"uri": []string{uri}}
+	if lang != "" {
+		query.Set("lang", lang)
+	}
+	path += "?" + query.Encode()
+
+	var compendium mapper.CompendiumLandingPage
+	b, err := zc.Get(ctx, userAccessToken, path)
+	if err != nil {
+		return compendium, err
+	}
+	if err = json.Unmarshal(b, &compendium); err != nil {
+		return compendium, &mapper.ValidationError{URI: uri, Service: serviceZebedee, Problems: []string{"content must be valid json: " + err.Error()}}
+	}
+
+	// Chapter links are not always resolved, in which case their titles are looked up individually
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, maxConcurrentChapterTitles)
+	for i, c := range compendium.Chapters {
+		if c.Title != "" {
+			continue
+		}
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(i int, uri string) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			pageTitle, err := zc.GetPageTitle(ctx, userAccessToken, collectionID, lang, uri)
+			if err != nil {
+				log.Warn(ctx, "unable to get compendium chapter title", log.FormatErrors([]error{err}), log.Data{"uri": uri})
+				return
+			}
+			compendium.Chapters[i].Title = pageTitle.Title
+		}(i, c.URI)
+	}
+	wg.Wait()
+
+	return compendium, nil
+}
@@ -0,0 +1,189 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/ONSdigital/dp-api-clients-go/v2/articles"
+	zebedee "github.com/ONSdigital/dp-api-clients-go/v2/zebedee"
+	"github.com/ONSdigital/dp-frontend-articles-controller/config"
+	"github.com/ONSdigital/dp-frontend-articles-controller/mapper"
+	gomock "github.com/golang/mock/gomock"
+	"github.com/gorilla/mux"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestUnitCompendiumHandlers(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	ctx := gomock.Any()
+
+	Convey("test compendium pages", t, func() {
+		requestUrlFormat := "http://localhost:26500%s"
+		landingPageURI := "/a/compendium/url"
+		chapterURI := landingPageURI + "/chapter2"
+		landingPageJSON := []byte(`{
+			"uri": "/a/compendium/url",
+			"description": {"title": "A compendium"},
+			"chapters": [
+				{"title": "Chapter 1", "uri": "/a/compendium/url/chapter1"},
+				{"uri": "/a/compendium/url/chapter2"}
+			]
+		}`)
+		landingPageDataPath := "/data/" + collectionID + "?lang=" + lang + "&uri=%2Fa%2Fcompendium%2Furl"
+
+		mockZebedeeClient := NewMockZebedeeClient(mockCtrl)
+		mockRenderClient := NewMockRenderClient(mockCtrl)
+		mockArticlesApiClient := NewMockArticlesApiClient(mockCtrl)
+		mockConfig := config.Config{}
+
+		router := mux.NewRouter()
+		router.PathPrefix("/").HandlerFunc(Page(mockConfig, mockRenderClient, mockZebedeeClient, mockArticlesApiClient))
+
+		w := httptest.NewRecorder()
+
+		Convey("it renders a compendium landing page with its chapters", func() {
+			l := articles.Bulletin{
+				URI:         landingPageURI,
+				Type:        "compendium_landing_page",
+				Description: zebedee.Description{Title: "Economic review"},
+			}
+			mockZebedeeClient.EXPECT().GetHomepageContent(ctx, accessToken, collectionID, lang, "/")
+			mockArticlesApiClient.EXPECT().GetLegacyBulletin(ctx, accessToken, collectionID, lang, landingPageURI).Return(&l, nil)
+			mockZebedeeClient.EXPECT().GetBreadcrumb(ctx, accessToken, collectionID, lang, landingPageURI)
+			mockZebedeeClient.EXPECT().Get(ctx, accessToken, landingPageDataPath).Return(landingPageJSON, nil)
+			mockZebedeeClient.EXPECT().GetPageTitle(ctx, accessToken, collectionID, lang, chapterURI).Return(zebedee.PageTitle{Title: "Chapter 2"}, nil)
+			mockRenderClient.EXPECT().NewBasePageModel()
+
+			var model mapper.CompendiumModel
+			mockRenderClient.EXPECT().BuildPage(gomock.Any(), gomock.AssignableToTypeOf(mapper.CompendiumModel{}), "compendium-landing-page").Do(
+				func(w io.Writer, m interface{}, templateName string) {
+					model = m.(mapper.CompendiumModel)
+				})
+
+			req := httptest.NewRequest("GET", fmt.Sprintf(requestUrlFormat, landingPageURI), nil)
+			setRequestHeaders(req)
+
+			router.ServeHTTP(w, req)
+
+			So(w.Code, ShouldEqual, http.StatusOK)
+			So(model.Chapters, ShouldHaveLength, 2)
+			So(model.Chapters[1].Title, ShouldEqual, "Chapter 2")
+			So(model.CurrentChapter, ShouldEqual, -1)
+		})
+
+		Convey("it renders a compendium chapter with the bulletin template", func() {
+			c := articles.Bulletin{
+				URI:         chapterURI,
+				Type:        "compendium_chapter",
+				Description: zebedee.Description{Title: "Chapter 2"},
+			}
+			mockZebedeeClient.EXPECT().GetHomepageContent(ctx, accessToken, collectionID, lang, "/")
+			mockArticlesApiClient.EXPECT().GetLegacyBulletin(ctx, accessToken, collectionID, lang, chapterURI).Return(&c, nil)
+			mockZebedeeClient.EXPECT().GetBreadcrumb(ctx, accessToken, collectionID, lang, chapterURI)
+			mockZebedeeClient.EXPECT().Get(ctx, accessToken, landingPageDataPath).Return(landingPageJSON, nil)
+			mockZebedeeClient.EXPECT().GetPageTitle(ctx, accessToken, collectionID, lang, chapterURI).Return(zebedee.PageTitle{}, errors.New("error reading title"))
+			mockRenderClient.EXPECT().NewBasePageModel()
+
+			var model mapper.CompendiumModel
+			mockRenderClient.EXPECT().BuildPage(gomock.Any(), gomock.AssignableToTypeOf(mapper.CompendiumModel{}), "bulletin").Do(
+				func(w io.Writer, m interface{}, templateName string) {
+					model = m.(mapper.CompendiumModel)
+				})
+
+			req := httptest.NewRequest("GET", fmt.Sprintf(requestUrlFormat, chapterURI), nil)
+			setRequestHeaders(req)
+
+			router.ServeHTTP(w, req)
+
+			So(w.Code, ShouldEqual, http.StatusOK)
+			So(model.CompendiumTitle, ShouldEqual, "A compendium")
+			So(model.CurrentChapter, ShouldEqual, 1)
+			So(model.PreviousChapter.URI, ShouldEqual, "/a/compendium/url/chapter1")
+			So(model.NextChapter, ShouldBeNil)
+		})
+
+		Convey("it returns 500 when there is an error getting the compendium landing page from Zebedee", func() {
+			c := articles.Bulletin{
+				URI:         chapterURI,
+				Type:        "compendium_chapter",
+				Description: zebedee.Description{Title: "Chapter 2"},
+			}
+			mockZebedeeClient.EXPECT().GetHomepageContent(ctx, accessToken, collectionID, lang, "/")
+			mockArticlesApiClient.EXPECT().GetLegacyBulletin(ctx, accessToken, collectionID, lang, chapterURI).Return(&c, nil)
+			mockZebedeeClient.EXPECT().GetBreadcrumb(ctx, accessToken, collectionID, lang, chapterURI)
+			mockZebedeeClient.EXPECT().Get(ctx, accessToken, landingPageDataPath).Return(nil, errors.New("error reading data"))
+			mockRenderClient.EXPECT().NewBasePageModel()
+			mockRenderClient.EXPECT().BuildPage(gomock.Any(), gomock.AssignableToTypeOf(mapper.ErrorModel{}), "error-page")
+
+			req := httptest.NewRequest("GET", fmt.Sprintf(requestUrlFormat, chapterURI), nil)
+			setRequestHeaders(req)
+
+			router.ServeHTTP(w, req)
+
+			So(w.Code, ShouldEqual, http.StatusInternalServerError)
+		})
+
+		compendiumErrorPage := func(data string, basePages int) int {
+			c := articles.Bulletin{
+				URI:         chapterURI,
+				Type:        "compendium_chapter",
+				Description: zebedee.Description{Title: "Chapter 2"},
+			}
+			mockZebedeeClient.EXPECT().GetHomepageContent(ctx, accessToken, collectionID, lang, "/")
+			mockArticlesApiClient.EXPECT().GetLegacyBulletin(ctx, accessToken, collectionID, lang, chapterURI).Return(&c, nil)
+			mockZebedeeClient.EXPECT().GetBreadcrumb(ctx, accessToken, collectionID, lang, chapterURI)
+			mockZebedeeClient.EXPECT().Get(ctx, accessToken, landingPageDataPath).Return([]byte(data), nil)
+			mockRenderClient.EXPECT().NewBasePageModel().Times(basePages)
+			mockRenderClient.EXPECT().BuildPage(gomock.Any(), gomock.AssignableToTypeOf(mapper.ErrorModel{}), "error-page")
+
+			req := httptest.NewRequest("GET", fmt.Sprintf(requestUrlFormat, chapterURI), nil)
+			setRequestHeaders(req)
+
+			router.ServeHTTP(w, req)
+			return w.Code
+		}
+
+		Convey("it returns 502 when the compendium landing page from Zebedee is not json", func() {
+			So(compendiumErrorPage(`not json`, 1), ShouldEqual, http.StatusBadGateway)
+		})
+
+		Convey("it returns 502 when a chapter of the compendium landing page from Zebedee is malformed", func() {
+			So(compendiumErrorPage(`{"uri": "/a/compendium/url", "chapters": [{"title": "Chapter 1", "uri": "chapter1"}]}`, 2), ShouldEqual, http.StatusBadGateway)
+		})
+
+		Convey("it escapes the uri and language of the compendium in the Zebedee path", func() {
+			mockZebedeeClient.EXPECT().Get(ctx, accessToken, "/data/"+collectionID+"?lang=en%26x%3D1&uri=%2Fa%2Fcompendium%3Fx%3D1%26lang%3Dcy").Return([]byte(`{}`), nil)
+
+			_, err := getCompendium(context.Background(), mockZebedeeClient, accessToken, collectionID, "en&x=1", "/a/compendium?x=1&lang=cy")
+
+			So(err, ShouldBeNil)
+		})
+
+		Convey("it looks up the titles of all untitled chapters", func() {
+			var chapters []string
+			for i := 1; i <= 2*maxConcurrentChapterTitles; i++ {
+				chapters = append(chapters, fmt.Sprintf(`{"uri": "/a/compendium/url/chapter%d"}`, i))
+			}
+			mockZebedeeClient.EXPECT().Get(ctx, accessToken, landingPageDataPath).Return([]byte(`{"chapters": [`+strings.Join(chapters, ",")+`]}`), nil)
+			mockZebedeeClient.EXPECT().GetPageTitle(ctx, accessToken, collectionID, lang, gomock.Any()).DoAndReturn(
+				func(_ context.Context, _, _, _, uri string) (zebedee.PageTitle, error) {
+					return zebedee.PageTitle{Title: "Title of " + uri}, nil
+				}).Times(len(chapters))
+
+			compendium, err := getCompendium(context.Background(), mockZebedeeClient, accessToken, collectionID, lang, landingPageURI)
+
+			So(err, ShouldBeNil)
+			So(compendium.Chapters, ShouldHaveLength, len(chapters))
+			for _, c := range compendium.Chapters {
+				So(c.Title, ShouldEqual, "Title of "+c.URI)
+			}
+		})
+	})
+}
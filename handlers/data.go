@@ -0,0 +1,121 @@
+package handlers
+
+import (
+	"encoding/json"
+	"fmt"
+	"mime"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/ONSdigital/dp-api-clients-go/v2/articles"
+	"github.com/ONSdigital/dp-frontend-articles-controller/config"
+	"github.com/ONSdigital/dp-frontend-articles-controller/mapper"
+	dphandlers "github.com/ONSdigital/dp-net/v2/handlers"
+	"github.com/ONSdigital/log.go/v2/log"
+)
+
+// defaultDataVersion is the version of the /data response returned when a request does not ask for a version. It is
+// the content as returned by the Articles API, which consumers relied on before the response was versioned, so that
+// they keep getting it until they ask for a version.
+const defaultDataVersion = ""
+
+// dataVersions maps each supported version of the /data response to the function that creates it
+var dataVersions = map[string]func(articles.Bulletin) interface{}{
+	defaultDataVersion: func(b articles.Bulletin) interface{} { return b },
+	"1":                func(b articles.Bulletin) interface{} { return mapper.CreateDataV1(b) },
+}
+
+// BulletinData handles requests for the content of a page as JSON. The version of the response is negotiated from
+// the version parameter of the Accept header, e.g. 'Accept: application/json; version=1'.
+func BulletinData(cfg config.Config, ac ArticlesApiClient) http.HandlerFunc {
+	return dphandlers.ControllerHandler(func(w http.ResponseWriter, req *http.Request, lang, collectionID, accessToken string) {
+		withCacheHeaders(w, req, cfg, collectionID, accessToken, func(w http.ResponseWriter) {
+			bulletinData(w, req, accessToken, collectionID, lang, ac, cfg)
+		})
+	})
+}
+
+func bulletinData(w http.ResponseWriter, req *http.Request, userAccessToken, collectionID, lang string, ac ArticlesApiClient, cfg config.Config) {
+	w.Header().Set("Vary", "Accept")
+
+	version, ok := negotiateDataVersion(req.Header.Get("Accept"))
+	if !ok {
+		log.Warn(req.Context(), "unsupported data version requested", log.Data{"accept": req.Header.Get("Accept")})
+		w.WriteHeader(http.StatusNotAcceptable)
+		return
+	}
+
+	bulletinUrl := strings.TrimSuffix(req.URL.EscapedPath(), "/data")
+	bulletin, err := ac.GetLegacyBulletin(req.Context(), userAccessToken, collectionID, lang, bulletinUrl)
+	if err != nil {
+		setStatusCode(req, w, serviceArticlesAPI, err)
+		return
+	}
+	if isEmbargoed(req.Context(), *bulletin, collectionID, userAccessToken, cfg.Now()) {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	data, err := json.Marshal(dataVersions[version](*bulletin))
+	if err != nil {
+		setStatusCode(req, w, "", err)
+		return
+	}
+
+	contentType := "application/json"
+	if version != defaultDataVersion {
+		contentType = fmt.Sprintf("application/json; version=%s", version)
+	}
+	w.Header().Set("content-type", contentType)
+	if _, err = w.Write(data); err != nil {
+		setStatusCode(req, w, "", err)
+		return
+	}
+}
+
+// negotiateDataVersion returns the version of the /data response to write for an Accept header. Media ranges that
+// accept JSON without a version are given the default version, and ok is false if no supported version is acceptable.
+// The acceptable range with the highest q value is chosen, with ties going to the range that comes first.
+func negotiateDataVersion(accept string) (version string, ok bool) {
+	if strings.TrimSpace(accept) == "" {
+		return defaultDataVersion, true
+	}
+
+	type acceptable struct {
+		version string
+		weight  float64
+	}
+	var ranges []acceptable
+	for _, mediaRange := range strings.Split(accept, ",") {
+		mediaType, params, err := mime.ParseMediaType(mediaRange)
+		if err != nil {
+			continue
+		}
+		if mediaType != "application/json" && mediaType != "application/*" && mediaType != "*/*" {
+			continue
+		}
+
+		weight := 1.0
+		if q, found := params["q"]; found {
+			if weight, err = strconv.ParseFloat(q, 64); err != nil || weight <= 0 {
+				continue
+			}
+		}
+
+		v, found := params["version"]
+		if !found {
+			v = defaultDataVersion
+		}
+		if _, supported := dataVersions[v]; supported {
+			ranges = append(ranges, acceptable{version: v, weight: weight})
+		}
+	}
+	if len(ranges) == 0 {
+		return "", false
+	}
+
+	sort.SliceStable(ranges, func(i, j int) bool { return ranges[i].weight > ranges[j].weight })
+	return ranges[0].version, true
+}
@@ -0,0 +1,107 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ONSdigital/dp-api-clients-go/v2/articles"
+	"github.com/ONSdigital/dp-frontend-articles-controller/config"
+	gomock "github.com/golang/mock/gomock"
+	"github.com/gorilla/mux"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestUnitNegotiateDataVersion(t *testing.T) {
+	Convey("negotiateDataVersion", t, func() {
+		testCases := []struct {
+			accept  string
+			version string
+			ok      bool
+		}{
+			{"", "", true},
+			{"*/*", "", true},
+			{"application/json", "", true},
+			{"application/*", "", true},
+			{"application/json; version=1", "1", true},
+			{"application/json;version=1;q=0.9", "1", true},
+			{"text/html, application/json; version=1", "1", true},
+			{"application/json; version=99, application/json; version=1", "1", true},
+			{"application/json; version=99", "", false},
+			{"application/json; q=0", "", false},
+			{"application/json; q=0.0", "", false},
+			{"application/json; q=0.000", "", false},
+			{"application/json; version=1; q=0.000, application/json; version=99", "", false},
+			{"application/json; version=1; q=0.001", "1", true},
+			{"application/json;version=1;q=0.1, application/json;q=0.9", "", true},
+			{"application/json;q=0.5, application/json;version=1", "1", true},
+			{"application/json;version=1;q=0.5, */*;q=0.5", "1", true},
+			{"*/*;q=0.5, application/json;version=1;q=0.5", "", true},
+			{"application/json;version=99;q=1, application/json;version=1;q=0.2", "1", true},
+			{"application/json;q=abc, application/json;version=1;q=0.2", "1", true},
+			{"text/html", "", false},
+			{"not a media type", "", false},
+		}
+
+		for _, tc := range testCases {
+			version, ok := negotiateDataVersion(tc.accept)
+			So(version, ShouldEqual, tc.version)
+			So(ok, ShouldEqual, tc.ok)
+		}
+	})
+}
+
+func TestUnitBulletinDataVersions(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	Convey("Given the /data endpoint", t, func() {
+		b := articles.Bulletin{URI: "/a/bulletin/url", Type: "bulletin"}
+		mockArticlesApiClient := NewMockArticlesApiClient(mockCtrl)
+		router := mux.NewRouter()
+		router.HandleFunc(b.URI+"/data", BulletinData(config.Config{}, mockArticlesApiClient))
+		w := httptest.NewRecorder()
+
+		Convey("When version 1 is requested", func() {
+			mockArticlesApiClient.EXPECT().GetLegacyBulletin(gomock.Any(), "", "", lang, b.URI).Return(&b, nil)
+			req := httptest.NewRequest("GET", b.URI+"/data", nil)
+			req.Header.Set("Accept", "application/json; version=1")
+
+			router.ServeHTTP(w, req)
+
+			Convey("Then version 1 is returned", func() {
+				So(w.Code, ShouldEqual, http.StatusOK)
+				So(w.Header().Get("Content-Type"), ShouldEqual, "application/json; version=1")
+				So(w.Header().Get("Vary"), ShouldEqual, "Accept")
+			})
+		})
+
+		Convey("When no version is requested", func() {
+			mockArticlesApiClient.EXPECT().GetLegacyBulletin(gomock.Any(), "", "", lang, b.URI).Return(&b, nil)
+			req := httptest.NewRequest("GET", b.URI+"/data", nil)
+			req.Header.Set("Accept", "application/json")
+
+			router.ServeHTTP(w, req)
+
+			Convey("Then the unversioned content is returned", func() {
+				js, _ := json.Marshal(b)
+				So(w.Code, ShouldEqual, http.StatusOK)
+				So(w.Header().Get("Content-Type"), ShouldEqual, "application/json")
+				So(w.Body.Bytes(), ShouldResemble, js)
+			})
+		})
+
+		Convey("When an unsupported version is requested", func() {
+			req := httptest.NewRequest("GET", b.URI+"/data", nil)
+			req.Header.Set("Accept", "application/json; version=2")
+
+			router.ServeHTTP(w, req)
+
+			Convey("Then the request is not acceptable and the content is not requested", func() {
+				So(w.Code, ShouldEqual, http.StatusNotAcceptable)
+				So(w.Header().Get("Cache-Control"), ShouldEqual, "no-store")
+			})
+		})
+	})
+}
@@ -0,0 +1,51 @@
+package handlers
+
+import (
+	"encoding/json"
+	"io"
+	"mime"
+	"net/http"
+	"strings"
+
+	"github.com/ONSdigital/dp-frontend-articles-controller/config"
+	"github.com/ONSdigital/log.go/v2/log"
+)
+
+// debugRenderClient writes page models as JSON instead of rendering them, so that the mapping of a page can be
+// inspected without a debugger
+type debugRenderClient struct {
+	RenderClient
+	req *http.Request
+}
+
+// withDebugJSON returns a RenderClient that writes page models as JSON, when DEBUG is set and the request asks for
+// JSON with '?format=json' or 'Accept: application/json'. It is never enabled in production builds.
+func withDebugJSON(rc RenderClient, req *http.Request, cfg config.Config) RenderClient {
+	if !debugJSONAvailable || !cfg.Debug || !wantsDebugJSON(req) {
+		return rc
+	}
+	return &debugRenderClient{RenderClient: rc, req: req}
+}
+
+func wantsDebugJSON(req *http.Request) bool {
+	if req.URL.Query().Get("format") == "json" {
+		return true
+	}
+	mediaType, _, err := mime.ParseMediaType(strings.Split(req.Header.Get("Accept"), ",")[0])
+	return err == nil && mediaType == "application/json"
+}
+
+// BuildPage writes the page model as indented JSON, using the json tags of the model
+func (d *debugRenderClient) BuildPage(w io.Writer, pageModel interface{}, templateName string) {
+	if rw, ok := w.(http.ResponseWriter); ok {
+		rw.Header().Set("Content-Type", "application/json")
+		rw.Header().Set("Vary", "Accept")
+		rw.Header().Set("X-Template-Name", templateName)
+	}
+
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(pageModel); err != nil {
+		log.Error(d.req.Context(), "failed to write page model as json", err, log.Data{"template": templateName})
+	}
+}
@@ -0,0 +1,7 @@
+//go:build !production
+// +build !production
+
+package handlers
+
+// debugJSONAvailable allows page models to be written as JSON in debug mode, in builds other than production
+const debugJSONAvailable = true
@@ -0,0 +1,7 @@
+//go:build production
+// +build production
+
+package handlers
+
+// debugJSONAvailable prevents page models from being written as JSON in production builds, even if DEBUG is set
+const debugJSONAvailable = false
@@ -0,0 +1,31 @@
+//go:build production
+// +build production
+
+package handlers
+
+import (
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ONSdigital/dp-frontend-articles-controller/config"
+	gomock "github.com/golang/mock/gomock"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestUnitDebugJSONProduction(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	Convey("Given a production build in debug mode", t, func() {
+		rc := NewMockRenderClient(mockCtrl)
+		req := httptest.NewRequest("GET", "/a/bulletin/url?format=json", nil)
+
+		Convey("When JSON is requested", func() {
+			debugRC := withDebugJSON(rc, req, config.Config{Debug: true})
+
+			Convey("Then pages are still rendered", func() {
+				So(debugRC, ShouldEqual, rc)
+			})
+		})
+	})
+}
@@ -0,0 +1,101 @@
+//go:build !production
+// +build !production
+
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ONSdigital/dp-api-clients-go/v2/articles"
+	"github.com/ONSdigital/dp-api-clients-go/v2/zebedee"
+	"github.com/ONSdigital/dp-frontend-articles-controller/config"
+	"github.com/ONSdigital/dp-frontend-articles-controller/mapper"
+	coreModel "github.com/ONSdigital/dp-renderer/model"
+	gomock "github.com/golang/mock/gomock"
+	"github.com/gorilla/mux"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestUnitDebugJSON(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	Convey("Given a bulletin page", t, func() {
+		url := "/a/bulletin/url"
+		b := articles.Bulletin{
+			URI:         url,
+			Type:        "bulletin",
+			Description: zebedee.Description{Title: "A bulletin"},
+			Sections:    []zebedee.Section{{Title: "Main points", Markdown: "Things happened"}},
+		}
+		mockZebedeeClient := NewMockZebedeeClient(mockCtrl)
+		mockRenderClient := NewMockRenderClient(mockCtrl)
+		mockArticlesApiClient := NewMockArticlesApiClient(mockCtrl)
+		mockArticlesApiClient.EXPECT().GetLegacyBulletin(gomock.Any(), "", "", lang, url).Return(&b, nil)
+		mockZebedeeClient.EXPECT().GetBreadcrumb(gomock.Any(), "", "", lang, url)
+		mockZebedeeClient.EXPECT().GetHomepageContent(gomock.Any(), "", "", lang, "/")
+		mockRenderClient.EXPECT().NewBasePageModel().Return(coreModel.Page{})
+		w := httptest.NewRecorder()
+
+		assertPageModel := func() {
+			So(w.Code, ShouldEqual, http.StatusOK)
+			So(w.Header().Get("Content-Type"), ShouldEqual, "application/json")
+			So(w.Header().Get("X-Template-Name"), ShouldEqual, "bulletin")
+
+			var model mapper.BulletinModel
+			So(json.Unmarshal(w.Body.Bytes(), &model), ShouldBeNil)
+			So(model.Metadata.Title, ShouldEqual, "A bulletin")
+			So(model.ContentsView, ShouldHaveLength, 1)
+			So(model.TableOfContents.Sections, ShouldNotBeEmpty)
+		}
+
+		Convey("When JSON is requested with the format parameter in debug mode", func() {
+			router := mux.NewRouter()
+			router.HandleFunc(url, Page(config.Config{Debug: true}, mockRenderClient, mockZebedeeClient, mockArticlesApiClient))
+
+			router.ServeHTTP(w, httptest.NewRequest("GET", url+"?format=json", nil))
+
+			Convey("Then the mapped page model is returned instead of being rendered", assertPageModel)
+		})
+
+		Convey("When JSON is requested with the Accept header in debug mode", func() {
+			router := mux.NewRouter()
+			router.HandleFunc(url, Page(config.Config{Debug: true}, mockRenderClient, mockZebedeeClient, mockArticlesApiClient))
+			req := httptest.NewRequest("GET", url, nil)
+			req.Header.Set("Accept", "application/json")
+
+			router.ServeHTTP(w, req)
+
+			Convey("Then the mapped page model is returned instead of being rendered", assertPageModel)
+		})
+
+		Convey("When JSON is requested without debug mode", func() {
+			router := mux.NewRouter()
+			router.HandleFunc(url, Page(config.Config{}, mockRenderClient, mockZebedeeClient, mockArticlesApiClient))
+			mockRenderClient.EXPECT().BuildPage(gomock.Any(), gomock.Any(), "bulletin")
+
+			router.ServeHTTP(w, httptest.NewRequest("GET", url+"?format=json", nil))
+
+			Convey("Then the page is rendered", func() {
+				So(w.Code, ShouldEqual, http.StatusOK)
+				So(w.Header().Get("Content-Type"), ShouldNotEqual, "application/json")
+			})
+		})
+	})
+
+	Convey("wantsDebugJSON", t, func() {
+		req := httptest.NewRequest("GET", "/a/bulletin/url", nil)
+		So(wantsDebugJSON(req), ShouldBeFalse)
+
+		req.Header.Set("Accept", "text/html,application/xhtml+xml,application/json;q=0.9")
+		So(wantsDebugJSON(req), ShouldBeFalse)
+
+		req.Header.Set("Accept", "application/json")
+		So(wantsDebugJSON(req), ShouldBeTrue)
+
+		So(wantsDebugJSON(httptest.NewRequest("GET", "/a/bulletin/url?format=json", nil)), ShouldBeTrue)
+	})
+}
@@ -0,0 +1,39 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/ONSdigital/dp-api-clients-go/v2/articles"
+	"github.com/ONSdigital/log.go/v2/log"
+)
+
+var errEmbargoed = errors.New("published content has a release date in the future")
+
+// isEmbargoed reports whether published content has a release date after now, and so must not be shown.
+// Content previewed in a collection is never embargoed. Published content that has not been released means that
+// the publishing pipeline has gone wrong, so it is logged at the highest severity to be alerted on.
+func isEmbargoed(ctx context.Context, content articles.Bulletin, collectionID, accessToken string, now time.Time) bool {
+	if collectionID != "" || accessToken != "" || content.Description.ReleaseDate == "" {
+		return false
+	}
+
+	releaseDate, err := time.Parse(time.RFC3339, content.Description.ReleaseDate)
+	if err != nil {
+		log.Warn(ctx, "unable to parse release date of published content", log.FormatErrors([]error{err}), log.Data{"uri": content.URI, "release_date": content.Description.ReleaseDate})
+		return false
+	}
+
+	if !releaseDate.After(now) {
+		return false
+	}
+
+	log.Fatal(ctx, "refusing to show published content before its release date", errEmbargoed, log.Data{
+		"alert":        true,
+		"uri":          content.URI,
+		"release_date": content.Description.ReleaseDate,
+		"current_time": now.UTC().Format(time.RFC3339),
+	})
+	return true
+}
@@ -0,0 +1,115 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/ONSdigital/dp-api-clients-go/v2/articles"
+	"github.com/ONSdigital/dp-api-clients-go/v2/zebedee"
+	"github.com/ONSdigital/dp-frontend-articles-controller/config"
+	"github.com/ONSdigital/dp-frontend-articles-controller/mapper"
+	gomock "github.com/golang/mock/gomock"
+	"github.com/gorilla/mux"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestUnitIsEmbargoed(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2022, 8, 12, 7, 0, 0, 0, time.UTC)
+
+	Convey("isEmbargoed", t, func() {
+		content := func(releaseDate string) articles.Bulletin {
+			return articles.Bulletin{URI: "/a/bulletin", Description: zebedee.Description{ReleaseDate: releaseDate}}
+		}
+
+		Convey("is false for published content that has been released", func() {
+			So(isEmbargoed(ctx, content("2022-08-12T06:00:00.000Z"), "", "", now), ShouldBeFalse)
+			So(isEmbargoed(ctx, content("2022-08-12T07:00:00Z"), "", "", now), ShouldBeFalse)
+		})
+
+		Convey("is true for published content with a release date in the future", func() {
+			So(isEmbargoed(ctx, content("2022-08-12T07:00:01.000Z"), "", "", now), ShouldBeTrue)
+			So(isEmbargoed(ctx, content("2022-08-12T08:30:00+01:00"), "", "", now), ShouldBeTrue)
+		})
+
+		Convey("is false for content previewed in a collection", func() {
+			So(isEmbargoed(ctx, content("2023-01-01T00:00:00.000Z"), collectionID, "", now), ShouldBeFalse)
+			So(isEmbargoed(ctx, content("2023-01-01T00:00:00.000Z"), "", accessToken, now), ShouldBeFalse)
+		})
+
+		Convey("is false for content without a valid release date", func() {
+			So(isEmbargoed(ctx, content(""), "", "", now), ShouldBeFalse)
+			So(isEmbargoed(ctx, content("12 August 2023"), "", "", now), ShouldBeFalse)
+		})
+	})
+}
+
+func TestUnitEmbargoedPage(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	Convey("Given a bulletin with a release date in the future", t, func() {
+		url := "/a/bulletin/url"
+		b := articles.Bulletin{
+			URI:         url,
+			Type:        "bulletin",
+			Description: zebedee.Description{Title: "A bulletin", ReleaseDate: "2022-08-12T09:30:00.000Z"},
+		}
+		mockZebedeeClient := NewMockZebedeeClient(mockCtrl)
+		mockRenderClient := NewMockRenderClient(mockCtrl)
+		mockArticlesApiClient := NewMockArticlesApiClient(mockCtrl)
+		cfg := config.Config{Clock: func() time.Time { return time.Date(2022, 8, 12, 7, 0, 0, 0, time.UTC) }}
+		router := mux.NewRouter()
+		router.HandleFunc(url, Page(cfg, mockRenderClient, mockZebedeeClient, mockArticlesApiClient))
+		router.HandleFunc(url+"/data", BulletinData(cfg, mockArticlesApiClient))
+		w := httptest.NewRecorder()
+
+		Convey("When the published page is requested", func() {
+			mockArticlesApiClient.EXPECT().GetLegacyBulletin(gomock.Any(), "", "", lang, url).Return(&b, nil)
+			mockZebedeeClient.EXPECT().GetHomepageContent(gomock.Any(), "", "", lang, "/")
+			mockRenderClient.EXPECT().NewBasePageModel()
+			mockRenderClient.EXPECT().BuildPage(gomock.Any(), gomock.AssignableToTypeOf(mapper.ErrorModel{}), "error-page").
+				Do(func(_ interface{}, model interface{}, _ string) {
+					So(model.(mapper.ErrorModel).StatusCode, ShouldEqual, http.StatusNotFound)
+					So(model.(mapper.ErrorModel).Error.Title, ShouldEqual, "Page not found")
+				})
+
+			router.ServeHTTP(w, httptest.NewRequest("GET", url, nil))
+
+			Convey("Then the not found page is rendered instead of the bulletin", func() {
+				So(w.Code, ShouldEqual, http.StatusNotFound)
+				So(w.Header().Get("Cache-Control"), ShouldEqual, "no-store")
+			})
+		})
+
+		Convey("When its published data is requested", func() {
+			mockArticlesApiClient.EXPECT().GetLegacyBulletin(gomock.Any(), "", "", lang, url).Return(&b, nil)
+
+			router.ServeHTTP(w, httptest.NewRequest("GET", url+"/data", nil))
+
+			Convey("Then it is not found", func() {
+				So(w.Code, ShouldEqual, http.StatusNotFound)
+				So(w.Body.Len(), ShouldEqual, 0)
+			})
+		})
+
+		Convey("When it is previewed in a collection", func() {
+			mockArticlesApiClient.EXPECT().GetLegacyBulletin(gomock.Any(), accessToken, collectionID, lang, url).Return(&b, nil)
+			mockZebedeeClient.EXPECT().GetHomepageContent(gomock.Any(), accessToken, collectionID, lang, "/")
+			mockZebedeeClient.EXPECT().GetBreadcrumb(gomock.Any(), accessToken, collectionID, lang, url)
+			mockRenderClient.EXPECT().NewBasePageModel()
+			mockRenderClient.EXPECT().BuildPage(gomock.Any(), gomock.Any(), "bulletin")
+			req := httptest.NewRequest("GET", url, nil)
+			setRequestHeaders(req)
+
+			router.ServeHTTP(w, req)
+
+			Convey("Then the bulletin is rendered", func() {
+				So(w.Code, ShouldEqual, http.StatusOK)
+			})
+		})
+	})
+}
@@ -0,0 +1,103 @@
+package handlers
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/ONSdigital/log.go/v2/log"
+)
+
+// fetchFunc makes a call to an upstream service, storing what it gets in a variable of the caller
+type fetchFunc func(ctx context.Context) error
+
+// fetchError is the error from the first required call of a fetcher to fail
+type fetchError struct {
+	name    string
+	service string
+	err     error
+}
+
+func (e *fetchError) Error() string { return e.name + ": " + e.err.Error() }
+func (e *fetchError) Unwrap() error { return e.err }
+
+// fetcher makes independent calls to upstream services concurrently. The failure of a required call cancels the
+// context of the other required calls, whereas an optional call is allowed to fail without affecting the page. Optional
+// calls are not cancelled, as what they get, e.g. the homepage content, is still used to render the error page.
+type fetcher struct {
+	ctx         context.Context
+	requiredCtx context.Context
+	cancel      context.CancelFunc
+	wg          sync.WaitGroup
+
+	mu        sync.Mutex
+	err       *fetchError
+	latencies map[string]time.Duration
+}
+
+func newFetcher(ctx context.Context) *fetcher {
+	requiredCtx, cancel := context.WithCancel(ctx)
+	return &fetcher{
+		ctx:         ctx,
+		requiredCtx: requiredCtx,
+		cancel:      cancel,
+		latencies:   make(map[string]time.Duration),
+	}
+}
+
+// required starts a call that the page cannot be rendered without
+func (f *fetcher) required(name, service string, call fetchFunc) {
+	f.start(name, service, true, call)
+}
+
+// optional starts a call that the page can be rendered without. A failure is logged and otherwise ignored.
+func (f *fetcher) optional(name, service string, call fetchFunc) {
+	f.start(name, service, false, call)
+}
+
+func (f *fetcher) start(name, service string, required bool, call fetchFunc) {
+	f.wg.Add(1)
+	go func() {
+		defer f.wg.Done()
+
+		ctx := f.ctx
+		if required {
+			ctx = f.requiredCtx
+		}
+
+		start := time.Now()
+		err := call(ctx)
+		latency := time.Since(start)
+
+		f.mu.Lock()
+		defer f.mu.Unlock()
+		f.latencies[name] = latency
+
+		if err == nil {
+			return
+		}
+		if !required {
+			log.Warn(f.ctx, "optional upstream call failed", log.FormatErrors([]error{err}), log.Data{"call": name, "upstream_service": service})
+			return
+		}
+		if f.err == nil {
+			f.err = &fetchError{name: name, service: service, err: err}
+			f.cancel()
+		}
+	}()
+}
+
+// wait waits for all of the calls to complete, returning the error from the first required call to fail. The
+// latency of each call is logged.
+func (f *fetcher) wait() *fetchError {
+	f.wg.Wait()
+	f.cancel()
+
+	latencies := make(log.Data, len(f.latencies))
+	for name, latency := range f.latencies {
+		latencies[name] = latency.Milliseconds()
+	}
+	log.Info(f.ctx, "upstream calls complete", log.Data{"latency_ms": latencies})
+
+	return f.err
+}
@@ -0,0 +1,101 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestUnitFetcher(t *testing.T) {
+	Convey("Given a fetcher", t, func() {
+		f := newFetcher(context.Background())
+
+		Convey("When independent calls are started", func() {
+			// Each call waits for the other to start, so they can only complete if they run concurrently
+			started := make(chan struct{}, 2)
+			call := func(ctx context.Context) error {
+				started <- struct{}{}
+				for len(started) < 2 {
+					select {
+					case <-time.After(time.Millisecond):
+					case <-ctx.Done():
+						return ctx.Err()
+					}
+				}
+				return nil
+			}
+			f.required("first", serviceZebedee, call)
+			f.optional("second", serviceZebedee, call)
+
+			Convey("Then they run at the same time, and the latency of each is recorded", func() {
+				So(f.wait(), ShouldBeNil)
+				So(f.latencies, ShouldContainKey, "first")
+				So(f.latencies, ShouldContainKey, "second")
+			})
+		})
+
+		Convey("When an optional call fails", func() {
+			var requiredCalled bool
+			f.optional("optional", serviceZebedee, func(ctx context.Context) error {
+				return errors.New("optional call failed")
+			})
+			f.required("required", serviceArticlesAPI, func(ctx context.Context) error {
+				requiredCalled = true
+				return nil
+			})
+
+			Convey("Then no error is returned", func() {
+				So(f.wait(), ShouldBeNil)
+				So(requiredCalled, ShouldBeTrue)
+			})
+		})
+
+		Convey("When a required call fails", func() {
+			callErr := errors.New("required call failed")
+			var otherErr, optionalErr error
+			optionalStarted := make(chan struct{})
+			f.optional("optional", serviceZebedee, func(ctx context.Context) error {
+				close(optionalStarted)
+				select {
+				case <-ctx.Done():
+					optionalErr = ctx.Err()
+				case <-time.After(10 * time.Millisecond):
+				}
+				return optionalErr
+			})
+			<-optionalStarted
+			f.required("failing", serviceArticlesAPI, func(ctx context.Context) error {
+				return callErr
+			})
+			f.required("other", serviceZebedee, func(ctx context.Context) error {
+				select {
+				case <-ctx.Done():
+					otherErr = ctx.Err()
+				case <-time.After(5 * time.Second):
+				}
+				return otherErr
+			})
+
+			ferr := f.wait()
+
+			Convey("Then its error is returned with the service that failed", func() {
+				So(ferr, ShouldNotBeNil)
+				So(ferr.name, ShouldEqual, "failing")
+				So(ferr.service, ShouldEqual, serviceArticlesAPI)
+				So(errors.Is(ferr, callErr), ShouldBeTrue)
+			})
+
+			Convey("And the other required calls are cancelled", func() {
+				So(otherErr, ShouldEqual, context.Canceled)
+			})
+
+			Convey("And the optional calls are allowed to complete", func() {
+				So(optionalErr, ShouldBeNil)
+				So(f.latencies, ShouldContainKey, "optional")
+			})
+		})
+	})
+}
@@ -1,34 +1,65 @@
 package handlers
 
 import (
-	"encoding/json"
+	"context"
 	"net/http"
-	"strings"
 
+	"github.com/ONSdigital/dp-api-clients-go/v2/articles"
+	"github.com/ONSdigital/dp-api-clients-go/v2/zebedee"
 	"github.com/ONSdigital/dp-frontend-articles-controller/config"
 	"github.com/ONSdigital/dp-frontend-articles-controller/mapper"
 	dphandlers "github.com/ONSdigital/dp-net/v2/handlers"
+	dprequest "github.com/ONSdigital/dp-net/v2/request"
+	coreModel "github.com/ONSdigital/dp-renderer/model"
 	"github.com/ONSdigital/log.go/v2/log"
 	"github.com/gorilla/mux"
 )
 
 const homepagePath = "/"
 
-func setStatusCode(req *http.Request, w http.ResponseWriter, err error) {
-	status := http.StatusInternalServerError
-	if err, ok := err.(ClientError); ok {
-		if err.Code() == http.StatusNotFound {
-			status = err.Code()
-		}
-	}
-	log.Error(req.Context(), "setting-response-status", err)
+func setStatusCode(req *http.Request, w http.ResponseWriter, service string, err error) {
+	status := mapError(w, req, service, err)
+	w.WriteHeader(status)
+}
+
+// handleError logs the error and renders the error page for the status that it maps to
+func handleError(w http.ResponseWriter, req *http.Request, service string, err error, lang string, homepageContent zebedee.HomepageContent, rc RenderClient) {
+	status := mapError(w, req, service, err)
+	renderErrorPage(w, req, status, lang, homepageContent, rc)
+}
+
+// renderErrorPage writes the response status and renders the error page for it
+func renderErrorPage(w http.ResponseWriter, req *http.Request, status int, lang string, homepageContent zebedee.HomepageContent, rc RenderClient) {
+	basePage := rc.NewBasePageModel()
+	model := mapper.CreateErrorModel(basePage, status, lang, getRequestID(req), homepageContent.ServiceMessage, homepageContent.EmergencyBanner)
 	w.WriteHeader(status)
+	rc.BuildPage(w, model, "error-page")
+}
+
+// getRequestID returns the ID that the request is logged against, so that it can be quoted by users to support teams
+func getRequestID(req *http.Request) string {
+	if id := dprequest.GetRequestId(req.Context()); id != "" {
+		return id
+	}
+	return req.Header.Get(dprequest.RequestHeaderKey)
+}
+
+// getHomepageContent gets the service message and emergency banner shown on every page. They are not essential
+// to a page, so a failure to get them is only logged.
+func getHomepageContent(ctx context.Context, zc ZebedeeClient, userAccessToken, collectionID, lang string) zebedee.HomepageContent {
+	homepageContent, err := zc.GetHomepageContent(ctx, userAccessToken, collectionID, lang, homepagePath)
+	if err != nil {
+		log.Warn(ctx, "unable to get homepage content", log.FormatErrors([]error{err}), log.Data{"homepage_content": err})
+	}
+	return homepageContent
 }
 
 // Bulletin handles bulletin requests
 func SixteensBulletin(cfg config.Config, rc RenderClient, zc ZebedeeClient, ac ArticlesApiClient) http.HandlerFunc {
 	return dphandlers.ControllerHandler(func(w http.ResponseWriter, r *http.Request, lang, collectionID, accessToken string) {
-		sixteensBulletin(w, r, accessToken, collectionID, lang, rc, zc, ac, cfg)
+		withCacheHeaders(w, r, cfg, collectionID, accessToken, func(w http.ResponseWriter) {
+			sixteensBulletin(w, r, accessToken, collectionID, lang, withTracing(withDebugJSON(rc, r, cfg), r), zc, ac, cfg)
+		})
 	})
 }
 
@@ -39,77 +70,132 @@ func sixteensBulletin(w http.ResponseWriter, req *http.Request, userAccessToken,
 	bulletin, err := ac.GetLegacyBulletin(ctx, userAccessToken, collectionID, lang, uri)
 
 	if err != nil {
-		setStatusCode(req, w, err)
+		handleError(w, req, serviceArticlesAPI, err, lang, getHomepageContent(ctx, zc, userAccessToken, collectionID, lang), rc)
+		return
+	}
+
+	if isEmbargoed(ctx, *bulletin, collectionID, userAccessToken, cfg.Now()) {
+		renderErrorPage(w, req, http.StatusNotFound, lang, getHomepageContent(ctx, zc, userAccessToken, collectionID, lang), rc)
 		return
 	}
 
 	breadcrumbs, err := zc.GetBreadcrumb(ctx, userAccessToken, collectionID, lang, bulletin.URI)
 	if err != nil {
-		setStatusCode(req, w, err)
+		handleError(w, req, serviceZebedee, err, lang, getHomepageContent(ctx, zc, userAccessToken, collectionID, lang), rc)
 		return
 	}
 
 	basePage := rc.NewBasePageModel()
-	model := mapper.CreateSixteensBulletinModel(basePage, *bulletin, breadcrumbs, lang)
+	span := startMappingSpan(ctx, "CreateSixteensBulletinModel")
+	model, err := mapper.CreateSixteensBulletinModel(basePage, *bulletin, breadcrumbs, lang)
+	span.End()
+	if err != nil {
+		handleError(w, req, mappingService(err), err, lang, getHomepageContent(ctx, zc, userAccessToken, collectionID, lang), rc)
+		return
+	}
 	rc.BuildPage(w, model, "sixteens-bulletin")
 }
 
-// Bulletin handles bulletin requests
-func Bulletin(cfg config.Config, rc RenderClient, zc ZebedeeClient, ac ArticlesApiClient) http.HandlerFunc {
+// Page handles requests for article-like pages, dispatching to the handler for the type of the requested content
+func Page(cfg config.Config, rc RenderClient, zc ZebedeeClient, ac ArticlesApiClient) http.HandlerFunc {
 	return dphandlers.ControllerHandler(func(w http.ResponseWriter, r *http.Request, lang, collectionID, accessToken string) {
-		bulletin(w, r, accessToken, collectionID, lang, rc, zc, ac, cfg)
+		withCacheHeaders(w, r, cfg, collectionID, accessToken, func(w http.ResponseWriter) {
+			page(w, r, accessToken, collectionID, lang, withTracing(withDebugJSON(rc, r, cfg), r), zc, ac, cfg)
+		})
 	})
 }
 
-func bulletin(w http.ResponseWriter, req *http.Request, userAccessToken, collectionID, lang string, rc RenderClient, zc ZebedeeClient, ac ArticlesApiClient, cfg config.Config) {
-	ctx := req.Context()
+// pageHandler renders a page for content of a given type that has already been fetched
+type pageHandler func(w http.ResponseWriter, req *http.Request, userAccessToken, collectionID, lang string, content articles.Bulletin, homepageContent zebedee.HomepageContent, rc RenderClient, zc ZebedeeClient, cfg config.Config)
 
-	homepageContent, err := zc.GetHomepageContent(ctx, userAccessToken, collectionID, lang, homepagePath)
-	if err != nil {
-		log.Warn(ctx, "unable to get homepage content", log.FormatErrors([]error{err}), log.Data{"homepage_content": err})
-	}
+var pageHandlers = map[string]pageHandler{
+	"bulletin":         bulletinTemplatePage("bulletin", "CreateBulletinModel", mapBulletin),
+	"article":          bulletinTemplatePage("article", "CreateArticleModel", mapArticle),
+	"article_download": bulletinTemplatePage("article", "CreateArticleModel", mapArticle),
 
-	bulletin, err := ac.GetLegacyBulletin(ctx, userAccessToken, collectionID, lang, req.URL.EscapedPath())
+	"compendium_landing_page": compendiumLandingPage,
+	"compendium_chapter":      compendiumChapter,
+}
 
-	if err != nil {
-		setStatusCode(req, w, err)
+func page(w http.ResponseWriter, req *http.Request, userAccessToken, collectionID, lang string, rc RenderClient, zc ZebedeeClient, ac ArticlesApiClient, cfg config.Config) {
+	ctx := req.Context()
+
+	// The homepage content is independent of the page, so is fetched at the same time
+	var homepageContent zebedee.HomepageContent
+	var content *articles.Bulletin
+	f := newFetcher(ctx)
+	f.optional("homepage_content", serviceZebedee, func(ctx context.Context) (err error) {
+		homepageContent, err = zc.GetHomepageContent(ctx, userAccessToken, collectionID, lang, homepagePath)
+		return err
+	})
+	f.required("legacy_bulletin", serviceArticlesAPI, func(ctx context.Context) (err error) {
+		content, err = ac.GetLegacyBulletin(ctx, userAccessToken, collectionID, lang, req.URL.EscapedPath())
+		return err
+	})
+	if ferr := f.wait(); ferr != nil {
+		handleError(w, req, ferr.service, ferr.err, lang, homepageContent, rc)
 		return
 	}
 
-	breadcrumbs, err := zc.GetBreadcrumb(ctx, userAccessToken, collectionID, lang, bulletin.URI)
-	if err != nil {
-		setStatusCode(req, w, err)
+	if isEmbargoed(ctx, *content, collectionID, userAccessToken, cfg.Now()) {
+		renderErrorPage(w, req, http.StatusNotFound, lang, homepageContent, rc)
 		return
 	}
 
-	basePage := rc.NewBasePageModel()
-	requestProtocol := "http"
-	if req.TLS != nil {
-		requestProtocol = "https"
+	handler, ok := pageHandlers[content.Type]
+	if !ok {
+		log.Warn(ctx, "unsupported page type", log.Data{"uri": content.URI, "type": content.Type})
+		renderErrorPage(w, req, http.StatusNotFound, lang, homepageContent, rc)
+		return
 	}
-	model := mapper.CreateBulletinModel(basePage, *bulletin, breadcrumbs, lang, requestProtocol, homepageContent.ServiceMessage, homepageContent.EmergencyBanner)
-	rc.BuildPage(w, model, "bulletin")
+
+	handler(w, req, userAccessToken, collectionID, lang, *content, homepageContent, rc, zc, cfg)
 }
 
-func BulletinData(cfg config.Config, ac ArticlesApiClient) http.HandlerFunc {
-	return dphandlers.ControllerHandler(func(w http.ResponseWriter, req *http.Request, lang, collectionID, accessToken string) {
-		bulletinUrl := strings.TrimSuffix(req.URL.EscapedPath(), "/data")
-		bulletin, err := ac.GetLegacyBulletin(req.Context(), accessToken, collectionID, lang, bulletinUrl)
+// contentMapper maps content to the model of a page rendered with the bulletin template, returning the figures that
+// its markdown references but that could not be resolved
+type contentMapper func(basePage coreModel.Page, content articles.Bulletin, bcs []zebedee.Breadcrumb, lang, requestProtocol, serviceMessage string, emergencyBannerContent zebedee.EmergencyBanner, socialImageURL string) (interface{}, []mapper.FigureReference, error)
+
+func mapBulletin(basePage coreModel.Page, content articles.Bulletin, bcs []zebedee.Breadcrumb, lang, requestProtocol, serviceMessage string, emergencyBannerContent zebedee.EmergencyBanner, socialImageURL string) (interface{}, []mapper.FigureReference, error) {
+	model, err := mapper.CreateBulletinModel(basePage, content, bcs, lang, requestProtocol, serviceMessage, emergencyBannerContent, socialImageURL)
+	return model, model.UnresolvedFigures, err
+}
+
+func mapArticle(basePage coreModel.Page, content articles.Bulletin, bcs []zebedee.Breadcrumb, lang, requestProtocol, serviceMessage string, emergencyBannerContent zebedee.EmergencyBanner, socialImageURL string) (interface{}, []mapper.FigureReference, error) {
+	model, err := mapper.CreateArticleModel(basePage, content, bcs, lang, requestProtocol, serviceMessage, emergencyBannerContent, socialImageURL)
+	return model, model.UnresolvedFigures, err
+}
+
+// bulletinTemplatePage returns the handler for a type of content that is rendered with the bulletin template. label
+// is the type of the content as it is logged, and mapperFunc the name of the mapper as it is traced.
+func bulletinTemplatePage(label, mapperFunc string, mapContent contentMapper) pageHandler {
+	return func(w http.ResponseWriter, req *http.Request, userAccessToken, collectionID, lang string, content articles.Bulletin, homepageContent zebedee.HomepageContent, rc RenderClient, zc ZebedeeClient, cfg config.Config) {
+		ctx := req.Context()
+
+		breadcrumbs, err := zc.GetBreadcrumb(ctx, userAccessToken, collectionID, lang, content.URI)
 		if err != nil {
-			setStatusCode(req, w, err)
+			handleError(w, req, serviceZebedee, err, lang, homepageContent, rc)
 			return
 		}
 
-		data, err := json.Marshal(bulletin)
+		basePage := rc.NewBasePageModel()
+		span := startMappingSpan(ctx, mapperFunc)
+		model, unresolvedFigures, err := mapContent(basePage, content, breadcrumbs, lang, getRequestProtocol(req), homepageContent.ServiceMessage, homepageContent.EmergencyBanner, cfg.SocialImageURL)
+		span.End()
 		if err != nil {
-			setStatusCode(req, w, err)
+			handleError(w, req, mappingService(err), err, lang, homepageContent, rc)
 			return
 		}
-
-		w.Header().Set("content-type", "application/json")
-		if _, err = w.Write(data); err != nil {
-			setStatusCode(req, w, err)
-			return
+		if len(unresolvedFigures) > 0 {
+			log.Warn(ctx, "unable to resolve figures referenced in "+label+" markdown", log.Data{"uri": content.URI, "figures": unresolvedFigures})
 		}
-	})
+		rc.BuildPage(w, model, "bulletin")
+	}
+}
+
+func getRequestProtocol(req *http.Request) string {
+	if req.TLS != nil {
+		return "https"
+	}
+	return "http"
 }
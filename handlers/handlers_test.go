@@ -4,14 +4,19 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"net/http"
 	"net/http/httptest"
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/ONSdigital/dp-api-clients-go/v2/articles"
 	"github.com/ONSdigital/dp-api-clients-go/v2/headers"
 	zebedee "github.com/ONSdigital/dp-api-clients-go/v2/zebedee"
 	"github.com/ONSdigital/dp-frontend-articles-controller/config"
+	"github.com/ONSdigital/dp-frontend-articles-controller/mapper"
+	"github.com/ONSdigital/dp-renderer/helper"
 	gomock "github.com/golang/mock/gomock"
 	"github.com/gorilla/mux"
 	. "github.com/smartystreets/goconvey/convey"
@@ -23,6 +28,13 @@ const (
 	collectionID = "collection"
 )
 
+func init() {
+	// Error pages are localised when they are mapped, so the service locale files are read from disk
+	helper.InitialiseLocalisationsHelper(func(name string) ([]byte, error) {
+		return os.ReadFile(filepath.Join("..", "assets", name))
+	})
+}
+
 type testCliError struct{}
 
 func (e *testCliError) Error() string { return "client error" }
@@ -40,7 +52,7 @@ func TestUnitHandlers(t *testing.T) {
 			w := httptest.NewRecorder()
 			err := &testCliError{}
 
-			setStatusCode(req, w, err)
+			setStatusCode(req, w, serviceArticlesAPI, err)
 
 			So(w.Code, ShouldEqual, http.StatusNotFound)
 		})
@@ -50,18 +62,51 @@ func TestUnitHandlers(t *testing.T) {
 			w := httptest.NewRecorder()
 			err := errors.New("internal server error")
 
-			setStatusCode(req, w, err)
+			setStatusCode(req, w, serviceArticlesAPI, err)
 
 			So(w.Code, ShouldEqual, http.StatusInternalServerError)
 		})
 	})
 
+	Convey("test renderErrorPage", t, func() {
+		mockRenderClient := NewMockRenderClient(mockCtrl)
+		homepageContent := zebedee.HomepageContent{
+			ServiceMessage: "a service message",
+			EmergencyBanner: zebedee.EmergencyBanner{
+				Title: "an emergency",
+			},
+		}
+		w := httptest.NewRecorder()
+
+		Convey("it renders the error page in the requested language with the request ID", func() {
+			var model mapper.ErrorModel
+			mockRenderClient.EXPECT().NewBasePageModel()
+			mockRenderClient.EXPECT().BuildPage(gomock.Any(), gomock.AssignableToTypeOf(mapper.ErrorModel{}), "error-page").Do(
+				func(w io.Writer, m interface{}, templateName string) {
+					model = m.(mapper.ErrorModel)
+				})
+
+			req := httptest.NewRequest("GET", "http://localhost:26500", nil)
+			req.Header.Set("X-Request-Id", "request-id")
+
+			renderErrorPage(w, req, http.StatusGone, "cy", homepageContent, mockRenderClient)
+
+			So(w.Code, ShouldEqual, http.StatusGone)
+			So(model.StatusCode, ShouldEqual, http.StatusGone)
+			So(model.Language, ShouldEqual, "cy")
+			So(model.RequestID, ShouldEqual, "request-id")
+			So(model.ServiceMessage, ShouldEqual, homepageContent.ServiceMessage)
+			So(model.EmergencyBanner.Title, ShouldEqual, homepageContent.EmergencyBanner.Title)
+		})
+	})
+
 	Convey("test SixteensBulletin", t, func() {
 		const requestUrlFormat = "http://localhost:26500/sixteens%s"
 		url := "/a/bulletin/url"
 		b := articles.Bulletin{
-			URI:  "/the/bulletin/url",
-			Type: "bulletin",
+			URI:         "/the/bulletin/url",
+			Type:        "bulletin",
+			Description: zebedee.Description{Title: "The bulletin"},
 		}
 		mockZebedeeClient := NewMockZebedeeClient(mockCtrl)
 		mockRenderClient := NewMockRenderClient(mockCtrl)
@@ -77,7 +122,7 @@ func TestUnitHandlers(t *testing.T) {
 			mockArticlesApiClient.EXPECT().GetLegacyBulletin(ctx, accessToken, collectionID, lang, url).Return(&b, nil)
 			mockZebedeeClient.EXPECT().GetBreadcrumb(ctx, accessToken, collectionID, lang, b.URI)
 			mockRenderClient.EXPECT().NewBasePageModel()
-			mockRenderClient.EXPECT().BuildPage(w, gomock.Any(), "sixteens-bulletin")
+			mockRenderClient.EXPECT().BuildPage(gomock.Any(), gomock.Any(), "sixteens-bulletin")
 
 			req := httptest.NewRequest("GET", fmt.Sprintf(requestUrlFormat, url), nil)
 			setRequestHeaders(req)
@@ -91,7 +136,7 @@ func TestUnitHandlers(t *testing.T) {
 			mockArticlesApiClient.EXPECT().GetLegacyBulletin(ctx, "", "", lang, url).Return(&b, nil)
 			mockZebedeeClient.EXPECT().GetBreadcrumb(ctx, "", "", lang, b.URI)
 			mockRenderClient.EXPECT().NewBasePageModel()
-			mockRenderClient.EXPECT().BuildPage(w, gomock.Any(), "sixteens-bulletin")
+			mockRenderClient.EXPECT().BuildPage(gomock.Any(), gomock.Any(), "sixteens-bulletin")
 
 			req := httptest.NewRequest("GET", fmt.Sprintf(requestUrlFormat, url), nil)
 
@@ -102,6 +147,9 @@ func TestUnitHandlers(t *testing.T) {
 
 		Convey("it returns 500 when there is an error getting the bulletin from Zebedee", func() {
 			mockArticlesApiClient.EXPECT().GetLegacyBulletin(ctx, accessToken, collectionID, lang, url).Return(nil, errors.New(("error reading data")))
+			mockZebedeeClient.EXPECT().GetHomepageContent(ctx, accessToken, collectionID, lang, "/")
+			mockRenderClient.EXPECT().NewBasePageModel()
+			mockRenderClient.EXPECT().BuildPage(gomock.Any(), gomock.AssignableToTypeOf(mapper.ErrorModel{}), "error-page")
 
 			req := httptest.NewRequest("GET", fmt.Sprintf(requestUrlFormat, url), nil)
 			setRequestHeaders(req)
@@ -114,6 +162,9 @@ func TestUnitHandlers(t *testing.T) {
 		Convey("it returns 500 when there is an error getting the breadcrumbs from Zebedee", func() {
 			mockArticlesApiClient.EXPECT().GetLegacyBulletin(ctx, accessToken, collectionID, lang, url).Return(&b, nil)
 			mockZebedeeClient.EXPECT().GetBreadcrumb(ctx, accessToken, collectionID, lang, b.URI).Return([]zebedee.Breadcrumb{}, errors.New(("error reading breadcrumbs")))
+			mockZebedeeClient.EXPECT().GetHomepageContent(ctx, accessToken, collectionID, lang, "/")
+			mockRenderClient.EXPECT().NewBasePageModel()
+			mockRenderClient.EXPECT().BuildPage(gomock.Any(), gomock.AssignableToTypeOf(mapper.ErrorModel{}), "error-page")
 			req := httptest.NewRequest("GET", fmt.Sprintf(requestUrlFormat, url), nil)
 			setRequestHeaders(req)
 
@@ -123,12 +174,13 @@ func TestUnitHandlers(t *testing.T) {
 		})
 	})
 
-	Convey("test Bulletin", t, func() {
+	Convey("test Page", t, func() {
 		requestUrlFormat := "http://localhost:26500%s"
 		url := "/a/bulletin/url"
 		b := articles.Bulletin{
-			URI:  "/the/bulletin/url",
-			Type: "bulletin",
+			URI:         "/the/bulletin/url",
+			Type:        "bulletin",
+			Description: zebedee.Description{Title: "The bulletin"},
 		}
 		mockZebedeeClient := NewMockZebedeeClient(mockCtrl)
 		mockRenderClient := NewMockRenderClient(mockCtrl)
@@ -136,7 +188,7 @@ func TestUnitHandlers(t *testing.T) {
 		mockConfig := config.Config{}
 
 		router := mux.NewRouter()
-		router.HandleFunc(url, Bulletin(mockConfig, mockRenderClient, mockZebedeeClient, mockArticlesApiClient))
+		router.HandleFunc(url, Page(mockConfig, mockRenderClient, mockZebedeeClient, mockArticlesApiClient))
 
 		w := httptest.NewRecorder()
 
@@ -145,7 +197,7 @@ func TestUnitHandlers(t *testing.T) {
 			mockZebedeeClient.EXPECT().GetBreadcrumb(ctx, accessToken, collectionID, lang, b.URI)
 			mockZebedeeClient.EXPECT().GetHomepageContent(ctx, accessToken, collectionID, lang, "/")
 			mockRenderClient.EXPECT().NewBasePageModel()
-			mockRenderClient.EXPECT().BuildPage(w, gomock.Any(), "bulletin")
+			mockRenderClient.EXPECT().BuildPage(gomock.Any(), gomock.Any(), "bulletin")
 
 			req := httptest.NewRequest("GET", fmt.Sprintf(requestUrlFormat, url), nil)
 			setRequestHeaders(req)
@@ -160,7 +212,7 @@ func TestUnitHandlers(t *testing.T) {
 			mockZebedeeClient.EXPECT().GetBreadcrumb(ctx, "", "", lang, b.URI)
 			mockZebedeeClient.EXPECT().GetHomepageContent(ctx, "", "", lang, "/")
 			mockRenderClient.EXPECT().NewBasePageModel()
-			mockRenderClient.EXPECT().BuildPage(w, gomock.Any(), "bulletin")
+			mockRenderClient.EXPECT().BuildPage(gomock.Any(), gomock.Any(), "bulletin")
 
 			req := httptest.NewRequest("GET", fmt.Sprintf(requestUrlFormat, url), nil)
 
@@ -169,9 +221,80 @@ func TestUnitHandlers(t *testing.T) {
 			So(w.Code, ShouldEqual, http.StatusOK)
 		})
 
+		Convey("it returns 502 when the bulletin from the articles API cannot be mapped", func() {
+			malformed := articles.Bulletin{URI: "/the/bulletin/url", Type: "bulletin"}
+			mockArticlesApiClient.EXPECT().GetLegacyBulletin(ctx, accessToken, collectionID, lang, url).Return(&malformed, nil)
+			mockZebedeeClient.EXPECT().GetBreadcrumb(ctx, accessToken, collectionID, lang, malformed.URI)
+			mockZebedeeClient.EXPECT().GetHomepageContent(ctx, accessToken, collectionID, lang, "/")
+			mockRenderClient.EXPECT().NewBasePageModel().Times(2)
+			mockRenderClient.EXPECT().BuildPage(gomock.Any(), gomock.AssignableToTypeOf(mapper.ErrorModel{}), "error-page")
+
+			req := httptest.NewRequest("GET", fmt.Sprintf(requestUrlFormat, url), nil)
+			setRequestHeaders(req)
+
+			router.ServeHTTP(w, req)
+
+			So(w.Code, ShouldEqual, http.StatusBadGateway)
+		})
+
+		Convey("it returns 200 when the homepage content cannot be retrieved", func() {
+			mockArticlesApiClient.EXPECT().GetLegacyBulletin(ctx, accessToken, collectionID, lang, url).Return(&b, nil)
+			mockZebedeeClient.EXPECT().GetBreadcrumb(ctx, accessToken, collectionID, lang, b.URI)
+			mockZebedeeClient.EXPECT().GetHomepageContent(ctx, accessToken, collectionID, lang, "/").Return(zebedee.HomepageContent{}, errors.New("error reading homepage content"))
+			mockRenderClient.EXPECT().NewBasePageModel()
+			mockRenderClient.EXPECT().BuildPage(gomock.Any(), gomock.Any(), "bulletin")
+
+			req := httptest.NewRequest("GET", fmt.Sprintf(requestUrlFormat, url), nil)
+			setRequestHeaders(req)
+
+			router.ServeHTTP(w, req)
+
+			So(w.Code, ShouldEqual, http.StatusOK)
+		})
+
+		Convey("it renders an article with the bulletin template", func() {
+			a := articles.Bulletin{
+				URI:         "/the/article/url",
+				Type:        "article",
+				Description: zebedee.Description{Title: "The article"},
+			}
+			mockArticlesApiClient.EXPECT().GetLegacyBulletin(ctx, accessToken, collectionID, lang, url).Return(&a, nil)
+			mockZebedeeClient.EXPECT().GetBreadcrumb(ctx, accessToken, collectionID, lang, a.URI)
+			mockZebedeeClient.EXPECT().GetHomepageContent(ctx, accessToken, collectionID, lang, "/")
+			mockRenderClient.EXPECT().NewBasePageModel()
+			mockRenderClient.EXPECT().BuildPage(gomock.Any(), gomock.AssignableToTypeOf(mapper.ArticleModel{}), "bulletin")
+
+			req := httptest.NewRequest("GET", fmt.Sprintf(requestUrlFormat, url), nil)
+			setRequestHeaders(req)
+
+			router.ServeHTTP(w, req)
+
+			So(w.Code, ShouldEqual, http.StatusOK)
+		})
+
+		Convey("it returns 404 when the page type is not supported", func() {
+			d := articles.Bulletin{
+				URI:  "/the/dataset/url",
+				Type: "dataset_landing_page",
+			}
+			mockArticlesApiClient.EXPECT().GetLegacyBulletin(ctx, accessToken, collectionID, lang, url).Return(&d, nil)
+			mockZebedeeClient.EXPECT().GetHomepageContent(ctx, accessToken, collectionID, lang, "/")
+			mockRenderClient.EXPECT().NewBasePageModel()
+			mockRenderClient.EXPECT().BuildPage(gomock.Any(), gomock.AssignableToTypeOf(mapper.ErrorModel{}), "error-page")
+
+			req := httptest.NewRequest("GET", fmt.Sprintf(requestUrlFormat, url), nil)
+			setRequestHeaders(req)
+
+			router.ServeHTTP(w, req)
+
+			So(w.Code, ShouldEqual, http.StatusNotFound)
+		})
+
 		Convey("it returns 500 when there is an error getting the bulletin from Zebedee", func() {
 			mockZebedeeClient.EXPECT().GetHomepageContent(ctx, accessToken, collectionID, lang, "/")
 			mockArticlesApiClient.EXPECT().GetLegacyBulletin(ctx, accessToken, collectionID, lang, url).Return(nil, errors.New(("error reading data")))
+			mockRenderClient.EXPECT().NewBasePageModel()
+			mockRenderClient.EXPECT().BuildPage(gomock.Any(), gomock.AssignableToTypeOf(mapper.ErrorModel{}), "error-page")
 
 			req := httptest.NewRequest("GET", fmt.Sprintf(requestUrlFormat, url), nil)
 			setRequestHeaders(req)
@@ -185,6 +308,8 @@ func TestUnitHandlers(t *testing.T) {
 			mockZebedeeClient.EXPECT().GetHomepageContent(ctx, accessToken, collectionID, lang, "/")
 			mockArticlesApiClient.EXPECT().GetLegacyBulletin(ctx, accessToken, collectionID, lang, url).Return(&b, nil)
 			mockZebedeeClient.EXPECT().GetBreadcrumb(ctx, accessToken, collectionID, lang, b.URI).Return([]zebedee.Breadcrumb{}, errors.New(("error reading breadcrumbs")))
+			mockRenderClient.EXPECT().NewBasePageModel()
+			mockRenderClient.EXPECT().BuildPage(gomock.Any(), gomock.AssignableToTypeOf(mapper.ErrorModel{}), "error-page")
 			req := httptest.NewRequest("GET", fmt.Sprintf(requestUrlFormat, url), nil)
 			setRequestHeaders(req)
 
@@ -240,6 +365,9 @@ func TestUnitHandlers(t *testing.T) {
 			Convey("and the content type is 'application/json' ", func() {
 				So(w.Header().Get("content-type"), ShouldEqual, "application/json")
 			})
+			Convey("and it has an ETag from the payload", func() {
+				So(w.Header().Get("ETag"), ShouldEqual, createETag(js))
+			})
 		})
 
 		Convey("it returns 500 when there is an error getting the release from the api", func() {
@@ -11,6 +11,7 @@ import (
 
 	articles "github.com/ONSdigital/dp-api-clients-go/v2/articles"
 	zebedee "github.com/ONSdigital/dp-api-clients-go/v2/zebedee"
+	pdf "github.com/ONSdigital/dp-frontend-articles-controller/pdf"
 	model "github.com/ONSdigital/dp-renderer/model"
 	gomock "github.com/golang/mock/gomock"
 )
@@ -138,6 +139,21 @@ func (m *MockZebedeeClient) EXPECT() *MockZebedeeClientMockRecorder {
 	return m.recorder
 }
 
+// Get mocks base method.
+func (m *MockZebedeeClient) Get(ctx context.Context, userAccessToken, path string) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, userAccessToken, path)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockZebedeeClientMockRecorder) Get(ctx, userAccessToken, path interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockZebedeeClient)(nil).Get), ctx, userAccessToken, path)
+}
+
 // GetBreadcrumb mocks base method.
 func (m *MockZebedeeClient) GetBreadcrumb(ctx context.Context, userAccessToken, collectionID, lang, uri string) ([]zebedee.Breadcrumb, error) {
 	m.ctrl.T.Helper()
@@ -168,6 +184,21 @@ func (mr *MockZebedeeClientMockRecorder) GetHomepageContent(ctx, userAccessToken
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHomepageContent", reflect.TypeOf((*MockZebedeeClient)(nil).GetHomepageContent), ctx, userAccessToken, collectionID, lang, path)
 }
 
+// GetPageTitle mocks base method.
+func (m *MockZebedeeClient) GetPageTitle(ctx context.Context, userAccessToken, collectionID, lang, uri string) (zebedee.PageTitle, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPageTitle", ctx, userAccessToken, collectionID, lang, uri)
+	ret0, _ := ret[0].(zebedee.PageTitle)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPageTitle indicates an expected call of GetPageTitle.
+func (mr *MockZebedeeClientMockRecorder) GetPageTitle(ctx, userAccessToken, collectionID, lang, uri interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPageTitle", reflect.TypeOf((*MockZebedeeClient)(nil).GetPageTitle), ctx, userAccessToken, collectionID, lang, uri)
+}
+
 // MockArticlesApiClient is a mock of ArticlesApiClient interface.
 type MockArticlesApiClient struct {
 	ctrl     *gomock.Controller
@@ -205,3 +236,41 @@ func (mr *MockArticlesApiClientMockRecorder) GetLegacyBulletin(ctx, userAccessTo
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLegacyBulletin", reflect.TypeOf((*MockArticlesApiClient)(nil).GetLegacyBulletin), ctx, userAccessToken, collectionID, lang, uri)
 }
+
+// MockPDFClient is a mock of PDFClient interface.
+type MockPDFClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockPDFClientMockRecorder
+}
+
+// MockPDFClientMockRecorder is the mock recorder for MockPDFClient.
+type MockPDFClientMockRecorder struct {
+	mock *MockPDFClient
+}
+
+// NewMockPDFClient creates a new mock instance.
+func NewMockPDFClient(ctrl *gomock.Controller) *MockPDFClient {
+	mock := &MockPDFClient{ctrl: ctrl}
+	mock.recorder = &MockPDFClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPDFClient) EXPECT() *MockPDFClientMockRecorder {
+	return m.recorder
+}
+
+// GetPDF mocks base method.
+func (m *MockPDFClient) GetPDF(ctx context.Context, userAccessToken, collectionID, lang, uri string) (*pdf.PDF, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPDF", ctx, userAccessToken, collectionID, lang, uri)
+	ret0, _ := ret[0].(*pdf.PDF)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPDF indicates an expected call of GetPDF.
+func (mr *MockPDFClientMockRecorder) GetPDF(ctx, userAccessToken, collectionID, lang, uri interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPDF", reflect.TypeOf((*MockPDFClient)(nil).GetPDF), ctx, userAccessToken, collectionID, lang, uri)
+}
@@ -0,0 +1,59 @@
+package handlers
+
+import (
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/ONSdigital/dp-frontend-articles-controller/config"
+	dphandlers "github.com/ONSdigital/dp-net/v2/handlers"
+	"github.com/ONSdigital/log.go/v2/log"
+)
+
+// PDF handles requests for the PDF version of a page, streaming it from the upstream PDF service. The page is got
+// first, so that the PDF of published content is not served before its release date.
+func PDF(cfg config.Config, rc RenderClient, zc ZebedeeClient, ac ArticlesApiClient, pc PDFClient) http.HandlerFunc {
+	return dphandlers.ControllerHandler(func(w http.ResponseWriter, req *http.Request, lang, collectionID, accessToken string) {
+		ctx := req.Context()
+		uri := strings.TrimSuffix(req.URL.EscapedPath(), "/pdf")
+
+		content, err := ac.GetLegacyBulletin(ctx, accessToken, collectionID, lang, uri)
+		if err != nil {
+			handleError(w, req, serviceArticlesAPI, err, lang, getHomepageContent(ctx, zc, accessToken, collectionID, lang), withTracing(rc, req))
+			return
+		}
+		if isEmbargoed(ctx, *content, collectionID, accessToken, cfg.Now()) {
+			renderErrorPage(w, req, http.StatusNotFound, lang, getHomepageContent(ctx, zc, accessToken, collectionID, lang), withTracing(rc, req))
+			return
+		}
+
+		pdf, err := pc.GetPDF(ctx, accessToken, collectionID, lang, uri)
+		if err != nil {
+			handleError(w, req, servicePDF, err, lang, getHomepageContent(ctx, zc, accessToken, collectionID, lang), withTracing(rc, req))
+			return
+		}
+		defer func() {
+			if err := pdf.Body.Close(); err != nil {
+				log.Error(ctx, "error closing pdf response body", err)
+			}
+		}()
+
+		contentType := pdf.ContentType
+		if contentType == "" {
+			contentType = "application/pdf"
+		}
+		w.Header().Set("Content-Type", contentType)
+		if pdf.ContentDisposition != "" {
+			w.Header().Set("Content-Disposition", pdf.ContentDisposition)
+		}
+		if pdf.ContentLength >= 0 {
+			w.Header().Set("Content-Length", strconv.FormatInt(pdf.ContentLength, 10))
+		}
+
+		// The status has been written by the time the body is copied, so a failure can only be logged
+		if _, err = io.Copy(w, pdf.Body); err != nil {
+			log.Error(ctx, "failed to stream pdf", err, log.Data{"uri": uri})
+		}
+	})
+}
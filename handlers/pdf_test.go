@@ -0,0 +1,158 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ONSdigital/dp-api-clients-go/v2/articles"
+	dperrors "github.com/ONSdigital/dp-api-clients-go/v2/errors"
+	zebedee "github.com/ONSdigital/dp-api-clients-go/v2/zebedee"
+	"github.com/ONSdigital/dp-frontend-articles-controller/config"
+	"github.com/ONSdigital/dp-frontend-articles-controller/mapper"
+	"github.com/ONSdigital/dp-frontend-articles-controller/pdf"
+	gomock "github.com/golang/mock/gomock"
+	"github.com/gorilla/mux"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestUnitPDFHandler(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	ctx := gomock.Any()
+
+	Convey("test PDF", t, func() {
+		requestUrlFormat := "http://localhost:26500%s"
+		uri := "/a/bulletin/url"
+		url := uri + "/pdf"
+
+		mockRenderClient := NewMockRenderClient(mockCtrl)
+		mockZebedeeClient := NewMockZebedeeClient(mockCtrl)
+		mockArticlesApiClient := NewMockArticlesApiClient(mockCtrl)
+		mockPDFClient := NewMockPDFClient(mockCtrl)
+		now := time.Date(2022, 8, 12, 7, 0, 0, 0, time.UTC)
+		mockConfig := config.Config{Clock: func() time.Time { return now }}
+		published := &articles.Bulletin{URI: uri, Description: zebedee.Description{ReleaseDate: "2022-08-12T06:00:00.000Z"}}
+
+		router := mux.NewRouter()
+		router.HandleFunc(url, PDF(mockConfig, mockRenderClient, mockZebedeeClient, mockArticlesApiClient, mockPDFClient))
+
+		w := httptest.NewRecorder()
+
+		Convey("it streams the pdf with the upstream headers", func() {
+			mockArticlesApiClient.EXPECT().GetLegacyBulletin(ctx, accessToken, collectionID, lang, uri).Return(published, nil)
+			mockPDFClient.EXPECT().GetPDF(ctx, accessToken, collectionID, lang, uri).Return(&pdf.PDF{
+				Body:               io.NopCloser(strings.NewReader("%PDF-1.4")),
+				ContentType:        "application/pdf",
+				ContentDisposition: `attachment; filename="bulletin.pdf"`,
+				ContentLength:      8,
+			}, nil)
+
+			req := httptest.NewRequest("GET", fmt.Sprintf(requestUrlFormat, url), nil)
+			setRequestHeaders(req)
+
+			router.ServeHTTP(w, req)
+
+			So(w.Code, ShouldEqual, http.StatusOK)
+			So(w.Body.String(), ShouldEqual, "%PDF-1.4")
+			So(w.Header().Get("Content-Type"), ShouldEqual, "application/pdf")
+			So(w.Header().Get("Content-Disposition"), ShouldEqual, `attachment; filename="bulletin.pdf"`)
+			So(w.Header().Get("Content-Length"), ShouldEqual, "8")
+		})
+
+		Convey("it omits the content length when the upstream does not set it", func() {
+			mockArticlesApiClient.EXPECT().GetLegacyBulletin(ctx, "", "", lang, uri).Return(published, nil)
+			mockPDFClient.EXPECT().GetPDF(ctx, "", "", lang, uri).Return(&pdf.PDF{
+				Body:          io.NopCloser(strings.NewReader("%PDF-1.4")),
+				ContentLength: -1,
+			}, nil)
+
+			req := httptest.NewRequest("GET", fmt.Sprintf(requestUrlFormat, url), nil)
+
+			router.ServeHTTP(w, req)
+
+			So(w.Code, ShouldEqual, http.StatusOK)
+			So(w.Header().Get("Content-Type"), ShouldEqual, "application/pdf")
+			So(w.Header().Get("Content-Length"), ShouldBeEmpty)
+		})
+
+		Convey("it renders the not found page for published content before its release date", func() {
+			embargoed := &articles.Bulletin{URI: uri, Description: zebedee.Description{ReleaseDate: "2022-08-12T08:00:00.000Z"}}
+			mockArticlesApiClient.EXPECT().GetLegacyBulletin(ctx, "", "", lang, uri).Return(embargoed, nil)
+			mockZebedeeClient.EXPECT().GetHomepageContent(ctx, "", "", lang, "/")
+			mockRenderClient.EXPECT().NewBasePageModel()
+			mockRenderClient.EXPECT().BuildPage(w, gomock.AssignableToTypeOf(mapper.ErrorModel{}), "error-page")
+
+			req := httptest.NewRequest("GET", fmt.Sprintf(requestUrlFormat, url), nil)
+
+			router.ServeHTTP(w, req)
+
+			So(w.Code, ShouldEqual, http.StatusNotFound)
+		})
+
+		Convey("it renders the not found page when the page does not exist", func() {
+			mockArticlesApiClient.EXPECT().GetLegacyBulletin(ctx, accessToken, collectionID, lang, uri).Return(nil, &testCliError{})
+			mockZebedeeClient.EXPECT().GetHomepageContent(ctx, accessToken, collectionID, lang, "/")
+			mockRenderClient.EXPECT().NewBasePageModel()
+			mockRenderClient.EXPECT().BuildPage(w, gomock.AssignableToTypeOf(mapper.ErrorModel{}), "error-page")
+
+			req := httptest.NewRequest("GET", fmt.Sprintf(requestUrlFormat, url), nil)
+			setRequestHeaders(req)
+
+			router.ServeHTTP(w, req)
+
+			So(w.Code, ShouldEqual, http.StatusNotFound)
+		})
+
+		Convey("it renders the not found page when the pdf does not exist", func() {
+			mockArticlesApiClient.EXPECT().GetLegacyBulletin(ctx, accessToken, collectionID, lang, uri).Return(published, nil)
+			mockPDFClient.EXPECT().GetPDF(ctx, accessToken, collectionID, lang, uri).Return(nil, &testCliError{})
+			mockZebedeeClient.EXPECT().GetHomepageContent(ctx, accessToken, collectionID, lang, "/")
+			mockRenderClient.EXPECT().NewBasePageModel()
+			mockRenderClient.EXPECT().BuildPage(w, gomock.AssignableToTypeOf(mapper.ErrorModel{}), "error-page")
+
+			req := httptest.NewRequest("GET", fmt.Sprintf(requestUrlFormat, url), nil)
+			setRequestHeaders(req)
+
+			router.ServeHTTP(w, req)
+
+			So(w.Code, ShouldEqual, http.StatusNotFound)
+		})
+
+		Convey("it renders the gateway timeout page when the pdf service does not respond in time", func() {
+			mockArticlesApiClient.EXPECT().GetLegacyBulletin(ctx, accessToken, collectionID, lang, uri).Return(published, nil)
+			mockPDFClient.EXPECT().GetPDF(ctx, accessToken, collectionID, lang, uri).Return(nil, dperrors.New(fmt.Errorf("failed to get response for pdf: %w", context.DeadlineExceeded), http.StatusInternalServerError, nil))
+			mockZebedeeClient.EXPECT().GetHomepageContent(ctx, accessToken, collectionID, lang, "/")
+			mockRenderClient.EXPECT().NewBasePageModel()
+			mockRenderClient.EXPECT().BuildPage(w, gomock.AssignableToTypeOf(mapper.ErrorModel{}), "error-page")
+
+			req := httptest.NewRequest("GET", fmt.Sprintf(requestUrlFormat, url), nil)
+			setRequestHeaders(req)
+
+			router.ServeHTTP(w, req)
+
+			So(w.Code, ShouldEqual, http.StatusGatewayTimeout)
+		})
+
+		Convey("it renders the internal server error page when there is an error getting the pdf", func() {
+			mockArticlesApiClient.EXPECT().GetLegacyBulletin(ctx, accessToken, collectionID, lang, uri).Return(published, nil)
+			mockPDFClient.EXPECT().GetPDF(ctx, accessToken, collectionID, lang, uri).Return(nil, errors.New("error reading pdf"))
+			mockZebedeeClient.EXPECT().GetHomepageContent(ctx, accessToken, collectionID, lang, "/")
+			mockRenderClient.EXPECT().NewBasePageModel()
+			mockRenderClient.EXPECT().BuildPage(w, gomock.AssignableToTypeOf(mapper.ErrorModel{}), "error-page")
+
+			req := httptest.NewRequest("GET", fmt.Sprintf(requestUrlFormat, url), nil)
+			setRequestHeaders(req)
+
+			router.ServeHTTP(w, req)
+
+			So(w.Code, ShouldEqual, http.StatusInternalServerError)
+		})
+	})
+}
@@ -0,0 +1,104 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/ONSdigital/dp-frontend-articles-controller/mapper"
+	"github.com/ONSdigital/dp-frontend-articles-controller/upstream"
+	dprequest "github.com/ONSdigital/dp-net/v2/request"
+	"github.com/ONSdigital/log.go/v2/log"
+)
+
+// Names of the upstream services, as logged when a request to one of them fails
+const (
+	serviceArticlesAPI = upstream.ArticlesAPI
+	serviceZebedee     = upstream.Zebedee
+	servicePDF         = upstream.PDF
+)
+
+// retryAfterSeconds is sent in the Retry-After header when an upstream service is overloaded or unavailable
+const retryAfterSeconds = "30"
+
+// statusMapping describes the response to send for a failed upstream response
+type statusMapping struct {
+	status int
+	// previewOnly mappings only apply when content is requested in a collection. Outside of a collection the upstream
+	// service should never refuse a request, so the failure is an internal error.
+	previewOnly bool
+	retryAfter  bool
+}
+
+// statusMappings maps the status of a failed upstream response to the status returned to the user. Any upstream
+// status that is not listed is returned as an internal server error.
+var statusMappings = map[int]statusMapping{
+	http.StatusUnauthorized:       {status: http.StatusUnauthorized, previewOnly: true},
+	http.StatusForbidden:          {status: http.StatusForbidden, previewOnly: true},
+	http.StatusNotFound:           {status: http.StatusNotFound},
+	http.StatusGone:               {status: http.StatusGone},
+	http.StatusTooManyRequests:    {status: http.StatusServiceUnavailable, retryAfter: true},
+	http.StatusServiceUnavailable: {status: http.StatusServiceUnavailable, retryAfter: true},
+	http.StatusGatewayTimeout:     {status: http.StatusGatewayTimeout},
+}
+
+// responseStatus is the response to send when a request to an upstream service has failed
+type responseStatus struct {
+	status         int
+	upstreamStatus int
+	retryAfter     bool
+}
+
+// getResponseStatus maps the error from a request to an upstream service to the response to send
+func getResponseStatus(err error, inCollection bool) responseStatus {
+	// Content that is too malformed to be mapped to a page is a fault of the upstream service
+	var validationErr *mapper.ValidationError
+	if errors.As(err, &validationErr) {
+		return responseStatus{status: http.StatusBadGateway}
+	}
+
+	upstreamStatus, _ := upstream.Status(err)
+	if upstream.IsTimeout(err) {
+		return responseStatus{status: http.StatusGatewayTimeout, upstreamStatus: upstreamStatus}
+	}
+
+	mapping, ok := statusMappings[upstreamStatus]
+	if !ok || (mapping.previewOnly && !inCollection) {
+		return responseStatus{status: http.StatusInternalServerError, upstreamStatus: upstreamStatus}
+	}
+
+	return responseStatus{
+		status:         mapping.status,
+		upstreamStatus: upstreamStatus,
+		retryAfter:     mapping.retryAfter,
+	}
+}
+
+// mappingService returns the upstream service that a failure to map content to a page is attributed to: the service
+// that the malformed content came from. It is empty for other failures, which are not caused by an upstream service.
+func mappingService(err error) string {
+	var validationErr *mapper.ValidationError
+	if errors.As(err, &validationErr) {
+		return validationErr.Service
+	}
+	return ""
+}
+
+// mapError maps the error from a request to an upstream service to the status to respond with, logging the failure
+// along with the chosen status. Any headers that go with the status are set on w. service is empty for errors that
+// did not come from an upstream service.
+func mapError(w http.ResponseWriter, req *http.Request, service string, err error) int {
+	collectionID, _ := dprequest.GetCollectionID(req)
+	rs := getResponseStatus(err, collectionID != "")
+
+	logData := log.Data{"status": rs.status}
+	if service != "" {
+		logData["upstream_service"] = service
+		logData["upstream_status"] = rs.upstreamStatus
+	}
+	log.Error(req.Context(), "setting-response-status", err, logData)
+
+	if rs.retryAfter {
+		w.Header().Set("Retry-After", retryAfterSeconds)
+	}
+	return rs.status
+}
@@ -0,0 +1,99 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	dperrors "github.com/ONSdigital/dp-api-clients-go/v2/errors"
+	"github.com/ONSdigital/dp-api-clients-go/v2/zebedee"
+	"github.com/ONSdigital/dp-frontend-articles-controller/mapper"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+type testTimeoutError struct{}
+
+func (e testTimeoutError) Error() string   { return "i/o timeout" }
+func (e testTimeoutError) Timeout() bool   { return true }
+func (e testTimeoutError) Temporary() bool { return true }
+
+func TestUnitStatus(t *testing.T) {
+	Convey("test getResponseStatus", t, func() {
+		zebedeeErr := func(code int) error {
+			return zebedee.ErrInvalidZebedeeResponse{ActualCode: code, URI: "/a/bulletin/url"}
+		}
+		articlesErr := func(code int) error {
+			return dperrors.New(errors.New("articles api error"), code, nil)
+		}
+
+		testCases := []struct {
+			name         string
+			err          error
+			inCollection bool
+			expected     responseStatus
+		}{
+			{"zebedee 401 in a collection", zebedeeErr(401), true, responseStatus{status: 401, upstreamStatus: 401}},
+			{"zebedee 401 outside a collection", zebedeeErr(401), false, responseStatus{status: 500, upstreamStatus: 401}},
+			{"zebedee 403 in a collection", zebedeeErr(403), true, responseStatus{status: 403, upstreamStatus: 403}},
+			{"zebedee 403 outside a collection", zebedeeErr(403), false, responseStatus{status: 500, upstreamStatus: 403}},
+			{"zebedee 404", zebedeeErr(404), false, responseStatus{status: 404, upstreamStatus: 404}},
+			{"articles api 404", articlesErr(404), false, responseStatus{status: 404, upstreamStatus: 404}},
+			{"articles api 410", articlesErr(410), false, responseStatus{status: 410, upstreamStatus: 410}},
+			{"articles api 429", articlesErr(429), false, responseStatus{status: 503, upstreamStatus: 429, retryAfter: true}},
+			{"zebedee 503", zebedeeErr(503), false, responseStatus{status: 503, upstreamStatus: 503, retryAfter: true}},
+			{"articles api 504", articlesErr(504), false, responseStatus{status: 504, upstreamStatus: 504}},
+			{"articles api 400", articlesErr(400), true, responseStatus{status: 500, upstreamStatus: 400}},
+			{"articles api 500", articlesErr(500), false, responseStatus{status: 500, upstreamStatus: 500}},
+			{"wrapped zebedee 404", fmt.Errorf("getting compendium: %w", zebedeeErr(404)), false, responseStatus{status: 404, upstreamStatus: 404}},
+			{"context deadline exceeded", context.DeadlineExceeded, false, responseStatus{status: 504}},
+			{"wrapped network timeout", fmt.Errorf("get: %w", testTimeoutError{}), false, responseStatus{status: 504}},
+			{"error without a status", errors.New("internal error"), false, responseStatus{status: 500}},
+			{"content that cannot be mapped", &mapper.ValidationError{URI: "a/bulletin/url"}, false, responseStatus{status: 502}},
+		}
+
+		for _, tc := range testCases {
+			Convey(fmt.Sprintf("it maps %s", tc.name), func() {
+				So(getResponseStatus(tc.err, tc.inCollection), ShouldResemble, tc.expected)
+			})
+		}
+	})
+
+	Convey("test mapError", t, func() {
+		w := httptest.NewRecorder()
+
+		Convey("it uses the collection of the request", func() {
+			req := httptest.NewRequest("GET", "http://localhost:26500", nil)
+			setRequestHeaders(req)
+
+			So(mapError(w, req, serviceZebedee, zebedee.ErrInvalidZebedeeResponse{ActualCode: 403}), ShouldEqual, http.StatusForbidden)
+		})
+
+		Convey("it sets Retry-After when the upstream service is unavailable", func() {
+			req := httptest.NewRequest("GET", "http://localhost:26500", nil)
+
+			So(mapError(w, req, serviceZebedee, zebedee.ErrInvalidZebedeeResponse{ActualCode: 429}), ShouldEqual, http.StatusServiceUnavailable)
+			So(w.Header().Get("Retry-After"), ShouldEqual, retryAfterSeconds)
+		})
+
+		Convey("it does not set Retry-After for other errors", func() {
+			req := httptest.NewRequest("GET", "http://localhost:26500", nil)
+
+			So(mapError(w, req, serviceZebedee, zebedee.ErrInvalidZebedeeResponse{ActualCode: 404}), ShouldEqual, http.StatusNotFound)
+			So(w.Header().Get("Retry-After"), ShouldBeEmpty)
+		})
+	})
+
+	Convey("test mappingService", t, func() {
+		Convey("it attributes malformed content to the service that it came from", func() {
+			So(mappingService(&mapper.ValidationError{Service: serviceArticlesAPI}), ShouldEqual, serviceArticlesAPI)
+			So(mappingService(fmt.Errorf("mapping: %w", &mapper.ValidationError{Service: serviceZebedee})), ShouldEqual, serviceZebedee)
+		})
+
+		Convey("it does not attribute other errors to an upstream service", func() {
+			So(mappingService(errors.New("internal error")), ShouldBeEmpty)
+		})
+	})
+}
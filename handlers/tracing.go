@@ -0,0 +1,36 @@
+package handlers
+
+import (
+	"context"
+	"io"
+	"net/http"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const instrumentationName = "github.com/ONSdigital/dp-frontend-articles-controller/handlers"
+
+// tracedRenderClient starts a span for each page rendered for a request
+type tracedRenderClient struct {
+	RenderClient
+	ctx context.Context
+}
+
+// withTracing returns a RenderClient that traces the rendering of pages as part of the request
+func withTracing(rc RenderClient, req *http.Request) RenderClient {
+	return &tracedRenderClient{RenderClient: rc, ctx: req.Context()}
+}
+
+// BuildPage renders a page from its model
+func (t *tracedRenderClient) BuildPage(w io.Writer, pageModel interface{}, templateName string) {
+	_, span := otel.Tracer(instrumentationName).Start(t.ctx, "render "+templateName)
+	defer span.End()
+	t.RenderClient.BuildPage(w, pageModel, templateName)
+}
+
+// startMappingSpan starts a span for mapping upstream content to a page model, which must be ended once mapped
+func startMappingSpan(ctx context.Context, mapperFunc string) trace.Span {
+	_, span := otel.Tracer(instrumentationName).Start(ctx, "mapper."+mapperFunc)
+	return span
+}
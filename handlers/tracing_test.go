@@ -0,0 +1,52 @@
+package handlers
+
+import (
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ONSdigital/dp-api-clients-go/v2/articles"
+	"github.com/ONSdigital/dp-api-clients-go/v2/zebedee"
+	"github.com/ONSdigital/dp-frontend-articles-controller/config"
+	gomock "github.com/golang/mock/gomock"
+	"github.com/gorilla/mux"
+	. "github.com/smartystreets/goconvey/convey"
+	"go.opentelemetry.io/otel"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func TestUnitTracing(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	recorder := tracetest.NewSpanRecorder()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+
+	Convey("Given a bulletin page", t, func() {
+		url := "/a/bulletin/url"
+		b := articles.Bulletin{URI: url, Type: "bulletin", Description: zebedee.Description{Title: "A bulletin"}}
+		mockZebedeeClient := NewMockZebedeeClient(mockCtrl)
+		mockRenderClient := NewMockRenderClient(mockCtrl)
+		mockArticlesApiClient := NewMockArticlesApiClient(mockCtrl)
+		router := mux.NewRouter()
+		router.HandleFunc(url, Page(config.Config{}, mockRenderClient, mockZebedeeClient, mockArticlesApiClient))
+
+		Convey("When it is requested", func() {
+			mockArticlesApiClient.EXPECT().GetLegacyBulletin(gomock.Any(), "", "", lang, url).Return(&b, nil)
+			mockZebedeeClient.EXPECT().GetBreadcrumb(gomock.Any(), "", "", lang, url)
+			mockZebedeeClient.EXPECT().GetHomepageContent(gomock.Any(), "", "", lang, "/")
+			mockRenderClient.EXPECT().NewBasePageModel()
+			mockRenderClient.EXPECT().BuildPage(gomock.Any(), gomock.Any(), "bulletin")
+
+			router.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest("GET", url, nil))
+
+			Convey("Then the mapping and rendering of the page are traced", func() {
+				var names []string
+				for _, span := range recorder.Ended() {
+					names = append(names, span.Name())
+				}
+				So(names, ShouldResemble, []string{"mapper.CreateBulletinModel", "render bulletin"})
+			})
+		})
+	})
+}
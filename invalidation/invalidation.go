@@ -0,0 +1,103 @@
+package invalidation
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/ONSdigital/dp-frontend-articles-controller/config"
+	"github.com/ONSdigital/log.go/v2/log"
+)
+
+// Evicter is a cache that content can be evicted from by URI prefix
+type Evicter interface {
+	EvictPrefix(prefix string) int
+}
+
+// Invalidator evicts cached content when it may be out of date
+type Invalidator struct {
+	evicters []Evicter
+	now      func() time.Time
+	after    func(d time.Duration) <-chan time.Time
+}
+
+// New creates an invalidator that evicts content from each of the evicters
+func New(evicters ...Evicter) *Invalidator {
+	return &Invalidator{
+		evicters: evicters,
+		now:      time.Now,
+		after:    time.After,
+	}
+}
+
+// Invalidate evicts all content with a URI that starts with prefix, returning the number of entries evicted
+func (i *Invalidator) Invalidate(ctx context.Context, prefix string) int {
+	var evicted int
+	for _, e := range i.evicters {
+		evicted += e.EvictPrefix(prefix)
+	}
+
+	log.Info(ctx, "invalidated cached content", log.Data{"uri_prefix": prefix, "evicted": evicted})
+	return evicted
+}
+
+// Schedule invalidates all cached content at each release time, until the context is done, so that pages are not
+// served from the cache once the content that they link to as the latest release has been replaced by a new release
+func (i *Invalidator) Schedule(ctx context.Context, releaseTimes config.ReleaseTimes) {
+	for {
+		next := releaseTimes.Next(i.now())
+		if next.IsZero() {
+			return
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-i.after(next.Sub(i.now())):
+			i.Invalidate(ctx, "/")
+		}
+	}
+}
+
+// invalidateRequest is the body of a request to the admin endpoint
+type invalidateRequest struct {
+	URIPrefix string `json:"uri_prefix"`
+}
+
+// invalidateResponse is the body of a response from the admin endpoint
+type invalidateResponse struct {
+	Evicted int `json:"evicted"`
+}
+
+// Handler is an admin endpoint that invalidates cached content with a URI that starts with the requested prefix
+func (i *Invalidator) Handler(w http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+
+	var body invalidateRequest
+	if err := json.NewDecoder(req.Body).Decode(&body); err != nil {
+		log.Warn(ctx, "invalid cache invalidation request", log.FormatErrors([]error{err}))
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	if !strings.HasPrefix(body.URIPrefix, "/") {
+		log.Warn(ctx, "invalid uri prefix in cache invalidation request", log.Data{"uri_prefix": body.URIPrefix})
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	data, err := json.Marshal(invalidateResponse{
+		Evicted: i.Invalidate(ctx, body.URIPrefix),
+	})
+	if err != nil {
+		log.Error(ctx, "failed to marshal cache invalidation response", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("content-type", "application/json")
+	if _, err = w.Write(data); err != nil {
+		log.Error(ctx, "failed to write cache invalidation response", err)
+	}
+}
@@ -0,0 +1,123 @@
+package invalidation
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ONSdigital/dp-frontend-articles-controller/cache"
+	"github.com/ONSdigital/dp-frontend-articles-controller/config"
+	"github.com/ONSdigital/dp-frontend-articles-controller/stale"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestUnitInvalidation(t *testing.T) {
+	ctx := context.Background()
+
+	Convey("Given a cache of bulletin content", t, func() {
+		c := cache.New(10, time.Hour, 0)
+		load := func(ctx context.Context) (interface{}, error) { return "content", nil }
+		for _, key := range []string{
+			"/economy/gdp/bulletins/gdp/2022|legacy-bulletin|en",
+			"/economy/gdp/bulletins/gdp/2022|breadcrumb|en",
+			"/economy/gdp/bulletins/gdp/latest|legacy-bulletin|en",
+			"/economy/inflation/bulletins/cpi/2022|legacy-bulletin|en",
+			"/|homepage-content|en",
+		} {
+			c.Get(ctx, key, load)
+		}
+		invalidator := New(c)
+
+		Convey("When a uri prefix is invalidated", func() {
+			evicted := invalidator.Invalidate(ctx, "/economy/gdp/bulletins/gdp/2022")
+
+			Convey("Then only content under the prefix is evicted", func() {
+				So(evicted, ShouldEqual, 2)
+				So(c.Stats().Size, ShouldEqual, 3)
+			})
+		})
+
+		Convey("When the admin endpoint is called", func() {
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest("POST", "/cache/invalidate", strings.NewReader(`{"uri_prefix": "/economy"}`))
+
+			invalidator.Handler(w, req)
+
+			Convey("Then the number of evicted entries is returned", func() {
+				So(w.Code, ShouldEqual, http.StatusOK)
+				So(w.Body.String(), ShouldEqual, `{"evicted":4}`)
+				So(c.Stats().Size, ShouldEqual, 1)
+			})
+		})
+
+		Convey("When the admin endpoint is called without a uri prefix", func() {
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest("POST", "/cache/invalidate", strings.NewReader(`{}`))
+
+			invalidator.Handler(w, req)
+
+			Convey("Then it is a bad request and nothing is evicted", func() {
+				So(w.Code, ShouldEqual, http.StatusBadRequest)
+				So(c.Stats().Size, ShouldEqual, 5)
+			})
+		})
+	})
+
+	Convey("Given a cache and a store of stale content", t, func() {
+		c := cache.New(10, time.Hour, 0)
+		c.Get(ctx, "/economy/gdp/bulletins/gdp/latest|legacy-bulletin|en", func(ctx context.Context) (interface{}, error) { return "content", nil })
+		s := stale.NewStore(10, time.Hour)
+		s.Put("/economy/gdp/bulletins/gdp/latest|legacy-bulletin|en", "content", time.Time{})
+		invalidator := New(c, s)
+
+		Convey("When content is invalidated at the release times", func() {
+			ctx, cancel := context.WithCancel(ctx)
+			clock := time.Date(2022, 8, 12, 6, 59, 0, 0, time.UTC)
+			waits := make(chan time.Duration)
+			invalidator.now = func() time.Time { return clock }
+			invalidator.after = func(d time.Duration) <-chan time.Time {
+				select {
+				case waits <- d:
+				case <-ctx.Done():
+					return make(chan time.Time)
+				}
+				clock = clock.Add(d)
+				fired := make(chan time.Time, 1)
+				fired <- clock
+				return fired
+			}
+			done := make(chan struct{})
+			go func() {
+				invalidator.Schedule(ctx, config.ReleaseTimes{7 * time.Hour, 9*time.Hour + 30*time.Minute})
+				close(done)
+			}()
+
+			firstWait := <-waits
+			secondWait := <-waits
+			cancel()
+			<-done
+
+			Convey("Then it waits until each release time in UK time", func() {
+				So(firstWait, ShouldEqual, 91*time.Minute)
+				So(secondWait, ShouldEqual, 21*time.Hour+30*time.Minute)
+			})
+
+			Convey("Then all content is evicted from both", func() {
+				So(c.Stats().Size, ShouldEqual, 0)
+				_, _, ok := s.Get("/economy/gdp/bulletins/gdp/latest|legacy-bulletin|en")
+				So(ok, ShouldBeFalse)
+			})
+		})
+
+		Convey("When there are no release times", func() {
+			invalidator.Schedule(ctx, nil)
+
+			Convey("Then nothing is evicted", func() {
+				So(c.Stats().Size, ShouldEqual, 1)
+			})
+		})
+	})
+}
@@ -0,0 +1,114 @@
+package mapper
+
+import (
+	"github.com/ONSdigital/dp-api-clients-go/v2/articles"
+	"github.com/ONSdigital/dp-api-clients-go/v2/zebedee"
+	coreModel "github.com/ONSdigital/dp-renderer/model"
+)
+
+// CompendiumLandingPage holds the fields of a legacy compendium landing page that are not part of articles.Bulletin
+type CompendiumLandingPage struct {
+	URI         string              `json:"uri"`
+	Description zebedee.Description `json:"description"`
+	Chapters    []zebedee.Link      `json:"chapters"`
+}
+
+// CompendiumModel is the page model for a compendium landing page or chapter, built on the fields it shares with a bulletin
+type CompendiumModel struct {
+	BulletinModel
+	CompendiumTitle string `json:"compendiumTitle"`
+	CompendiumURI   string `json:"compendiumUri"`
+	Chapters        []Link `json:"chapters"`
+	CurrentChapter  int    `json:"currentChapter"`
+	PreviousChapter *Link  `json:"previousChapter"`
+	NextChapter     *Link  `json:"nextChapter"`
+}
+
+// CompendiumLandingPageURI returns the URI of the landing page that a compendium chapter belongs to
+func CompendiumLandingPageURI(chapterURI string) string {
+	return parentPath(chapterURI)
+}
+
+// CreateCompendiumLandingPageModel maps a compendium landing page. Each chapter is listed as a section of the page
+// so that the chapters appear in the table of contents, in place of any sections or accordion of the landing page
+// itself. A *ValidationError is returned if the landing page or compendium is too malformed to be mapped.
+func CreateCompendiumLandingPageModel(basePage coreModel.Page, landingPage articles.Bulletin, compendium CompendiumLandingPage, bcs []zebedee.Breadcrumb, lang, requestProtocol, serviceMessage string, emergencyBannerContent zebedee.EmergencyBanner, socialImageURL string) (CompendiumModel, error) {
+	if err := validateCompendium(compendium); err != nil {
+		return CompendiumModel{}, err
+	}
+
+	landingPage.Sections = make([]zebedee.Section, 0, len(compendium.Chapters))
+	for _, c := range compendium.Chapters {
+		landingPage.Sections = append(landingPage.Sections, zebedee.Section{
+			Title:    c.Title,
+			Markdown: c.Summary,
+		})
+	}
+	landingPage.Accordion = nil
+
+	bulletinModel, err := CreateBulletinModel(basePage, landingPage, bcs, lang, requestProtocol, serviceMessage, emergencyBannerContent, socialImageURL)
+	if err != nil {
+		return CompendiumModel{}, err
+	}
+
+	model := CompendiumModel{BulletinModel: bulletinModel}
+	model.CompendiumTitle = landingPage.Description.Title
+	model.CompendiumURI = landingPage.URI
+	model.Chapters = mapChapters(compendium.Chapters)
+	model.CurrentChapter = -1
+
+	return model, nil
+}
+
+// CreateCompendiumChapterModel maps a compendium chapter, with navigation to the other chapters of its compendium. A
+// *ValidationError is returned if the chapter or its compendium is too malformed to be mapped.
+func CreateCompendiumChapterModel(basePage coreModel.Page, chapter articles.Bulletin, compendium CompendiumLandingPage, bcs []zebedee.Breadcrumb, lang, requestProtocol, serviceMessage string, emergencyBannerContent zebedee.EmergencyBanner, socialImageURL string) (CompendiumModel, error) {
+	if err := validateCompendium(compendium); err != nil {
+		return CompendiumModel{}, err
+	}
+
+	bulletinModel, err := CreateBulletinModel(basePage, chapter, bcs, lang, requestProtocol, serviceMessage, emergencyBannerContent, socialImageURL)
+	if err != nil {
+		return CompendiumModel{}, err
+	}
+
+	model := CompendiumModel{BulletinModel: bulletinModel}
+	model.CompendiumTitle = compendium.Description.Title
+	model.CompendiumURI = compendium.URI
+	model.Chapters = mapChapters(compendium.Chapters)
+
+	// The landing page is the parent of the chapter, but is not part of the taxonomy breadcrumb
+	if n := len(model.Breadcrumb); n == 0 || model.Breadcrumb[n-1].URI != compendium.URI {
+		model.Breadcrumb = append(model.Breadcrumb, coreModel.TaxonomyNode{
+			Title: compendium.Description.Title,
+			URI:   compendium.URI,
+		})
+	}
+
+	model.CurrentChapter = -1
+	for i, c := range model.Chapters {
+		if c.URI == chapter.URI {
+			model.CurrentChapter = i
+			break
+		}
+	}
+	if model.CurrentChapter > 0 {
+		model.PreviousChapter = &model.Chapters[model.CurrentChapter-1]
+	}
+	if model.CurrentChapter >= 0 && model.CurrentChapter < len(model.Chapters)-1 {
+		model.NextChapter = &model.Chapters[model.CurrentChapter+1]
+	}
+
+	return model, nil
+}
+
+func mapChapters(chapters []zebedee.Link) []Link {
+	links := []Link{}
+	for _, c := range chapters {
+		links = append(links, Link{
+			Title: c.Title,
+			URI:   c.URI,
+		})
+	}
+	return links
+}
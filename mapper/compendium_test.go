@@ -0,0 +1,123 @@
+package mapper
+
+import (
+	"testing"
+
+	"github.com/ONSdigital/dp-api-clients-go/v2/articles"
+	"github.com/ONSdigital/dp-api-clients-go/v2/zebedee"
+	"github.com/ONSdigital/dp-frontend-articles-controller/mocks"
+	"github.com/ONSdigital/dp-renderer/helper"
+	coreModel "github.com/ONSdigital/dp-renderer/model"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestUnitCompendiumMapper(t *testing.T) {
+	helper.InitialiseLocalisationsHelper(mocks.MockAssetFunction)
+
+	Convey("Given a compendium with three chapters", t, func() {
+		compendium := CompendiumLandingPage{
+			URI: "/economy/compendium/2022",
+			Description: zebedee.Description{
+				Title: "Economic review",
+			},
+			Chapters: []zebedee.Link{
+				{Title: "Chapter 1", URI: "/economy/compendium/2022/chapter1", Summary: "The first chapter"},
+				{Title: "Chapter 2", URI: "/economy/compendium/2022/chapter2", Summary: "The second chapter"},
+				{Title: "Chapter 3", URI: "/economy/compendium/2022/chapter3", Summary: "The third chapter"},
+			},
+		}
+		bcs := []zebedee.Breadcrumb{
+			{URI: "/", Description: zebedee.NodeDescription{Title: "Root"}},
+			{URI: "/economy", Description: zebedee.NodeDescription{Title: "Economy"}},
+		}
+
+		Convey("CompendiumLandingPageURI returns the parent of a chapter", func() {
+			So(CompendiumLandingPageURI(compendium.Chapters[1].URI), ShouldEqual, compendium.URI)
+		})
+
+		Convey("When the landing page is mapped", func() {
+			landingPage := articles.Bulletin{
+				URI:         compendium.URI,
+				Type:        "compendium_landing_page",
+				Description: compendium.Description,
+				Accordion:   []zebedee.Section{{Title: "Notes", Markdown: "Some notes"}},
+			}
+			model, err := CreateCompendiumLandingPageModel(coreModel.Page{}, landingPage, compendium, bcs, "en", "http", "", zebedee.EmergencyBanner{}, "")
+			So(err, ShouldBeNil)
+
+			Convey("Then each chapter is a section of the page", func() {
+				So(model.Sections, ShouldHaveLength, 3)
+				So(model.Sections[0].Title, ShouldEqual, "Chapter 1")
+				So(model.Sections[0].Markdown, ShouldEqual, "The first chapter")
+				So(model.TableOfContents.Sections, ShouldContainKey, "section-1")
+			})
+
+			Convey("And the accordion of the landing page is not listed, as there is no chapter for it", func() {
+				So(model.Accordion, ShouldBeEmpty)
+				So(model.ContentsView, ShouldHaveLength, 3)
+			})
+
+			Convey("And the chapters are listed without a current chapter", func() {
+				So(model.CompendiumTitle, ShouldEqual, "Economic review")
+				So(model.CompendiumURI, ShouldEqual, compendium.URI)
+				So(model.Chapters, ShouldHaveLength, 3)
+				So(model.CurrentChapter, ShouldEqual, -1)
+				So(model.PreviousChapter, ShouldBeNil)
+				So(model.NextChapter, ShouldBeNil)
+			})
+		})
+
+		Convey("When the middle chapter is mapped", func() {
+			chapter := articles.Bulletin{
+				URI:         compendium.Chapters[1].URI,
+				Type:        "compendium_chapter",
+				Description: zebedee.Description{Title: compendium.Chapters[1].Title},
+			}
+			model, err := CreateCompendiumChapterModel(coreModel.Page{}, chapter, compendium, bcs, "en", "http", "", zebedee.EmergencyBanner{}, "")
+			So(err, ShouldBeNil)
+
+			Convey("Then it links to the previous and next chapters", func() {
+				So(model.CurrentChapter, ShouldEqual, 1)
+				So(model.PreviousChapter.URI, ShouldEqual, compendium.Chapters[0].URI)
+				So(model.NextChapter.URI, ShouldEqual, compendium.Chapters[2].URI)
+			})
+
+			Convey("And the landing page is the last breadcrumb", func() {
+				So(model.Breadcrumb, ShouldHaveLength, 3)
+				So(model.Breadcrumb[2].URI, ShouldEqual, compendium.URI)
+				So(model.Breadcrumb[2].Title, ShouldEqual, "Economic review")
+			})
+		})
+
+		Convey("When the first chapter is mapped", func() {
+			chapter := articles.Bulletin{
+				URI:         compendium.Chapters[0].URI,
+				Description: zebedee.Description{Title: compendium.Chapters[0].Title},
+			}
+			model, err := CreateCompendiumChapterModel(coreModel.Page{}, chapter, compendium, bcs, "en", "http", "", zebedee.EmergencyBanner{}, "")
+			So(err, ShouldBeNil)
+
+			Convey("Then there is no previous chapter", func() {
+				So(model.CurrentChapter, ShouldEqual, 0)
+				So(model.PreviousChapter, ShouldBeNil)
+				So(model.NextChapter.URI, ShouldEqual, compendium.Chapters[1].URI)
+			})
+		})
+
+		Convey("When the last chapter is mapped", func() {
+			chapter := articles.Bulletin{
+				URI:         compendium.Chapters[2].URI,
+				Description: zebedee.Description{Title: compendium.Chapters[2].Title},
+			}
+			model, err := CreateCompendiumChapterModel(coreModel.Page{}, chapter, compendium, bcs, "en", "http", "", zebedee.EmergencyBanner{}, "")
+			So(err, ShouldBeNil)
+
+			Convey("Then there is no next chapter", func() {
+				So(model.CurrentChapter, ShouldEqual, 2)
+				So(model.PreviousChapter.URI, ShouldEqual, compendium.Chapters[1].URI)
+				So(model.NextChapter, ShouldBeNil)
+			})
+		})
+	})
+}
@@ -0,0 +1,171 @@
+package mapper
+
+import (
+	"github.com/ONSdigital/dp-api-clients-go/v2/articles"
+	"github.com/ONSdigital/dp-api-clients-go/v2/zebedee"
+)
+
+// DataV1 is version 1 of the response of the /data endpoint, described by schemas/bulletin-data-v1.json. Fields
+// must not be removed or change type within a version, so that consumers of the endpoint do not break when the
+// upstream content changes shape. Lists are always present, and empty rather than null.
+type DataV1 struct {
+	URI               string          `json:"uri"`
+	Type              string          `json:"type"`
+	Title             string          `json:"title"`
+	Edition           string          `json:"edition"`
+	Summary           string          `json:"summary"`
+	MetaDescription   string          `json:"metaDescription"`
+	Keywords          []string        `json:"keywords"`
+	ReleaseDate       string          `json:"releaseDate"`
+	NextReleaseDate   string          `json:"nextReleaseDate"`
+	NationalStatistic bool            `json:"nationalStatistic"`
+	WelshStatistic    bool            `json:"welshStatistic"`
+	LatestRelease     bool            `json:"latestRelease"`
+	LatestReleaseURI  string          `json:"latestReleaseUri"`
+	Contact           DataContactV1   `json:"contact"`
+	Sections          []DataSectionV1 `json:"sections"`
+	Accordion         []DataSectionV1 `json:"accordion"`
+	Charts            []DataFigureV1  `json:"charts"`
+	Tables            []DataFigureV1  `json:"tables"`
+	Images            []DataFigureV1  `json:"images"`
+	Equations         []DataFigureV1  `json:"equations"`
+	RelatedBulletins  []DataLinkV1    `json:"relatedBulletins"`
+	RelatedData       []DataLinkV1    `json:"relatedData"`
+	Links             []DataLinkV1    `json:"links"`
+	Versions          []DataVersionV1 `json:"versions"`
+	Alerts            []DataAlertV1   `json:"alerts"`
+}
+
+// DataContactV1 is the contact for the content in version 1 of the /data response
+type DataContactV1 struct {
+	Name      string `json:"name"`
+	Email     string `json:"email"`
+	Telephone string `json:"telephone"`
+}
+
+// DataSectionV1 is a markdown section in version 1 of the /data response
+type DataSectionV1 struct {
+	Title    string `json:"title"`
+	Markdown string `json:"markdown"`
+}
+
+// DataFigureV1 is a chart, table, image or equation in version 1 of the /data response
+type DataFigureV1 struct {
+	Title    string `json:"title"`
+	Filename string `json:"filename"`
+	Version  string `json:"version"`
+	URI      string `json:"uri"`
+}
+
+// DataLinkV1 is a link to other content in version 1 of the /data response
+type DataLinkV1 struct {
+	Title   string `json:"title"`
+	Summary string `json:"summary"`
+	URI     string `json:"uri"`
+}
+
+// DataVersionV1 is a previous version of the content in version 1 of the /data response
+type DataVersionV1 struct {
+	URI              string `json:"uri"`
+	ReleaseDate      string `json:"releaseDate"`
+	CorrectionNotice string `json:"correctionNotice"`
+	Label            string `json:"label"`
+}
+
+// DataAlertV1 is a notice or correction in version 1 of the /data response
+type DataAlertV1 struct {
+	Date     string `json:"date"`
+	Markdown string `json:"markdown"`
+	Type     string `json:"type"`
+}
+
+// CreateDataV1 maps content from the articles API to version 1 of the /data response
+func CreateDataV1(bulletin articles.Bulletin) DataV1 {
+	d := bulletin.Description
+	data := DataV1{
+		URI:               bulletin.URI,
+		Type:              bulletin.Type,
+		Title:             d.Title,
+		Edition:           d.Edition,
+		Summary:           d.Summary,
+		MetaDescription:   d.MetaDescription,
+		Keywords:          []string{},
+		ReleaseDate:       d.ReleaseDate,
+		NextReleaseDate:   d.NextRelease,
+		NationalStatistic: d.NationalStatistic,
+		WelshStatistic:    d.WelshStatistic,
+		LatestRelease:     d.LatestRelease,
+		LatestReleaseURI:  bulletin.LatestReleaseURI,
+		Contact: DataContactV1{
+			Name:      d.Contact.Name,
+			Email:     d.Contact.Email,
+			Telephone: d.Contact.Telephone,
+		},
+		Sections:         mapDataSectionsV1(bulletin.Sections),
+		Accordion:        mapDataSectionsV1(bulletin.Accordion),
+		Charts:           mapDataFiguresV1(bulletin.Charts),
+		Tables:           mapDataFiguresV1(bulletin.Tables),
+		Images:           mapDataFiguresV1(bulletin.Images),
+		Equations:        mapDataFiguresV1(bulletin.Equations),
+		RelatedBulletins: mapDataLinksV1(bulletin.RelatedBulletins),
+		RelatedData:      mapDataLinksV1(bulletin.RelatedData),
+		Links:            mapDataLinksV1(bulletin.Links),
+		Versions:         []DataVersionV1{},
+		Alerts:           []DataAlertV1{},
+	}
+	data.Keywords = append(data.Keywords, d.Keywords...)
+
+	for _, v := range bulletin.Versions {
+		data.Versions = append(data.Versions, DataVersionV1{
+			URI:              v.URI,
+			ReleaseDate:      v.ReleaseDate,
+			CorrectionNotice: v.Notice,
+			Label:            v.Label,
+		})
+	}
+	for _, a := range bulletin.Alerts {
+		data.Alerts = append(data.Alerts, DataAlertV1{
+			Date:     a.Date,
+			Markdown: a.Markdown,
+			Type:     a.Type,
+		})
+	}
+
+	return data
+}
+
+func mapDataSectionsV1(sections []zebedee.Section) []DataSectionV1 {
+	mapped := []DataSectionV1{}
+	for _, s := range sections {
+		mapped = append(mapped, DataSectionV1{
+			Title:    s.Title,
+			Markdown: s.Markdown,
+		})
+	}
+	return mapped
+}
+
+func mapDataFiguresV1(figures []zebedee.Figure) []DataFigureV1 {
+	mapped := []DataFigureV1{}
+	for _, f := range figures {
+		mapped = append(mapped, DataFigureV1{
+			Title:    f.Title,
+			Filename: f.Filename,
+			Version:  f.Version,
+			URI:      f.URI,
+		})
+	}
+	return mapped
+}
+
+func mapDataLinksV1(links []zebedee.Link) []DataLinkV1 {
+	mapped := []DataLinkV1{}
+	for _, l := range links {
+		mapped = append(mapped, DataLinkV1{
+			Title:   l.Title,
+			Summary: l.Summary,
+			URI:     l.URI,
+		})
+	}
+	return mapped
+}
@@ -0,0 +1,142 @@
+package mapper
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/ONSdigital/dp-api-clients-go/v2/articles"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+// The tests in this file check that version 1 of the /data response stays compatible with its published schema and
+// with the responses that consumers already rely on. If they fail, the change needs a new version of the response.
+
+func readTestData(t *testing.T, name string) []byte {
+	b, err := os.ReadFile(filepath.Join("testdata", name))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return b
+}
+
+func readSchema(t *testing.T, name string) map[string]interface{} {
+	b, err := os.ReadFile(filepath.Join("..", "schemas", name))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var schema map[string]interface{}
+	if err = json.Unmarshal(b, &schema); err != nil {
+		t.Fatal(err)
+	}
+	return schema
+}
+
+func TestUnitDataV1Compatibility(t *testing.T) {
+	schema := readSchema(t, "bulletin-data-v1.json")
+
+	Convey("Given a bulletin from the articles API", t, func() {
+		var bulletin articles.Bulletin
+		So(json.Unmarshal(readTestData(t, "legacy-bulletin.json"), &bulletin), ShouldBeNil)
+
+		Convey("When it is mapped to version 1 of the data response", func() {
+			data, err := json.Marshal(CreateDataV1(bulletin))
+			So(err, ShouldBeNil)
+
+			Convey("Then the response is unchanged", func() {
+				So(string(data), ShouldEqualJSON, string(readTestData(t, "bulletin-data-v1.json")))
+			})
+
+			Convey("And it is valid against the published schema", func() {
+				So(validateSchema(schema, schema, decode(data), "$"), ShouldBeEmpty)
+			})
+		})
+	})
+
+	Convey("Given an empty bulletin", t, func() {
+		Convey("When it is mapped to version 1 of the data response", func() {
+			data, err := json.Marshal(CreateDataV1(articles.Bulletin{}))
+			So(err, ShouldBeNil)
+
+			Convey("Then it is still valid against the published schema", func() {
+				So(validateSchema(schema, schema, decode(data), "$"), ShouldBeEmpty)
+			})
+		})
+	})
+}
+
+func decode(data []byte) interface{} {
+	var v interface{}
+	if err := json.Unmarshal(data, &v); err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// validateSchema checks a decoded JSON value against the subset of JSON Schema used by the published schemas:
+// type, properties, required, additionalProperties, items and local $ref. It returns a description of each error.
+func validateSchema(root, schema map[string]interface{}, value interface{}, path string) []string {
+	if ref, ok := schema["$ref"].(string); ok {
+		definition := root
+		for _, name := range strings.Split(strings.TrimPrefix(ref, "#/"), "/") {
+			definition, _ = definition[name].(map[string]interface{})
+		}
+		if definition == nil {
+			return []string{fmt.Sprintf("%s: unresolved $ref %s", path, ref)}
+		}
+		return validateSchema(root, definition, value, path)
+	}
+
+	var errs []string
+	switch schema["type"] {
+	case "object":
+		obj, ok := value.(map[string]interface{})
+		if !ok {
+			return []string{fmt.Sprintf("%s: expected object, got %T", path, value)}
+		}
+		properties, _ := schema["properties"].(map[string]interface{})
+		required, _ := schema["required"].([]interface{})
+		for _, name := range required {
+			if _, ok := obj[name.(string)]; !ok {
+				errs = append(errs, fmt.Sprintf("%s: missing required property %s", path, name))
+			}
+		}
+		names := make([]string, 0, len(obj))
+		for name := range obj {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			property, ok := properties[name].(map[string]interface{})
+			if !ok {
+				if schema["additionalProperties"] == false {
+					errs = append(errs, fmt.Sprintf("%s: unexpected property %s", path, name))
+				}
+				continue
+			}
+			errs = append(errs, validateSchema(root, property, obj[name], path+"."+name)...)
+		}
+	case "array":
+		arr, ok := value.([]interface{})
+		if !ok {
+			return []string{fmt.Sprintf("%s: expected array, got %T", path, value)}
+		}
+		items, _ := schema["items"].(map[string]interface{})
+		for i, item := range arr {
+			errs = append(errs, validateSchema(root, items, item, fmt.Sprintf("%s[%d]", path, i))...)
+		}
+	case "string":
+		if _, ok := value.(string); !ok {
+			errs = append(errs, fmt.Sprintf("%s: expected string, got %T", path, value))
+		}
+	case "boolean":
+		if _, ok := value.(bool); !ok {
+			errs = append(errs, fmt.Sprintf("%s: expected boolean, got %T", path, value))
+		}
+	}
+	return errs
+}
@@ -0,0 +1,58 @@
+package mapper
+
+import (
+	"net/http"
+
+	"github.com/ONSdigital/dp-api-clients-go/v2/zebedee"
+	"github.com/ONSdigital/dp-renderer/helper"
+	coreModel "github.com/ONSdigital/dp-renderer/model"
+)
+
+// errorPageLocaleKeys are the locale keys for the title and description of each error page. Statuses not listed
+// here are shown as an internal server error.
+var errorPageLocaleKeys = map[int][2]string{
+	http.StatusUnauthorized:        {"ErrorPageUnauthorizedTitle", "ErrorPageUnauthorizedDescription"},
+	http.StatusForbidden:           {"ErrorPageForbiddenTitle", "ErrorPageForbiddenDescription"},
+	http.StatusNotFound:            {"ErrorPageNotFoundTitle", "ErrorPageNotFoundDescription"},
+	http.StatusGone:                {"ErrorPageGoneTitle", "ErrorPageGoneDescription"},
+	http.StatusInternalServerError: {"ErrorPageInternalServerErrorTitle", "ErrorPageInternalServerErrorDescription"},
+	http.StatusBadGateway:          {"ErrorPageBadGatewayTitle", "ErrorPageBadGatewayDescription"},
+	http.StatusServiceUnavailable:  {"ErrorPageServiceUnavailableTitle", "ErrorPageServiceUnavailableDescription"},
+	http.StatusGatewayTimeout:      {"ErrorPageGatewayTimeoutTitle", "ErrorPageGatewayTimeoutDescription"},
+}
+
+// ErrorModel is the page model for a rendered error page
+type ErrorModel struct {
+	coreModel.Page
+	StatusCode int    `json:"statusCode"`
+	RequestID  string `json:"requestId"`
+}
+
+// CreateErrorModel maps an error page for the given response status. The request ID is shown on the page so that
+// users can quote it to support teams.
+func CreateErrorModel(basePage coreModel.Page, status int, lang, requestID, serviceMessage string, emergencyBannerContent zebedee.EmergencyBanner) ErrorModel {
+	keys, ok := errorPageLocaleKeys[status]
+	if !ok {
+		keys = errorPageLocaleKeys[http.StatusInternalServerError]
+	}
+
+	model := ErrorModel{
+		Page:       basePage,
+		StatusCode: status,
+		RequestID:  requestID,
+	}
+	model.Type = "error"
+	model.Language = lang
+	model.ServiceMessage = serviceMessage
+	model.EmergencyBanner = mapEmergencyBanner(emergencyBannerContent)
+	model.BetaBannerEnabled = true
+	model.SearchNoIndexEnabled = true
+	model.Error = coreModel.Error{
+		Title:       helper.Localise(keys[0], lang, 1),
+		Description: helper.Localise(keys[1], lang, 1),
+		Language:    lang,
+	}
+	model.Metadata.Title = model.Error.Title
+
+	return model
+}
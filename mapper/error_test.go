@@ -0,0 +1,108 @@
+package mapper
+
+import (
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ONSdigital/dp-api-clients-go/v2/zebedee"
+	"github.com/ONSdigital/dp-renderer/helper"
+	coreModel "github.com/ONSdigital/dp-renderer/model"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+// localeAsset reads the service locale files from disk, so that the error page text can be localised in tests
+func localeAsset(name string) ([]byte, error) {
+	return os.ReadFile(filepath.Join("..", "assets", name))
+}
+
+func TestUnitErrorMapper(t *testing.T) {
+	helper.InitialiseLocalisationsHelper(localeAsset)
+
+	Convey("Given a base page", t, func() {
+		basePage := coreModel.NewPage("path/to/assets", "site-domain")
+		banner := zebedee.EmergencyBanner{
+			Type:        "notable_death",
+			Title:       "This is not not an emergency",
+			Description: "Something has gone wrong",
+			URI:         "https://www.ons.gov.uk/",
+			LinkText:    "More info",
+		}
+
+		Convey("When a not found error page is mapped", func() {
+			model := CreateErrorModel(basePage, http.StatusNotFound, "en", "request-id", "a service message", banner)
+
+			Convey("Then the not found title and description are used", func() {
+				So(model.StatusCode, ShouldEqual, http.StatusNotFound)
+				So(model.Type, ShouldEqual, "error")
+				So(model.Language, ShouldEqual, "en")
+				So(model.Error.Title, ShouldEqual, "Page not found")
+				So(model.Metadata.Title, ShouldEqual, model.Error.Title)
+				So(model.Error.Description, ShouldNotBeEmpty)
+				So(model.SearchNoIndexEnabled, ShouldBeTrue)
+			})
+
+			Convey("And the request ID, service message and emergency banner are included", func() {
+				So(model.RequestID, ShouldEqual, "request-id")
+				So(model.ServiceMessage, ShouldEqual, "a service message")
+				So(model.EmergencyBanner.Type, ShouldEqual, "notable-death")
+				So(model.EmergencyBanner.Title, ShouldEqual, banner.Title)
+			})
+		})
+
+		Convey("When gone and service unavailable error pages are mapped", func() {
+			gone := CreateErrorModel(basePage, http.StatusGone, "en", "", "", zebedee.EmergencyBanner{})
+			unavailable := CreateErrorModel(basePage, http.StatusServiceUnavailable, "en", "", "", zebedee.EmergencyBanner{})
+
+			Convey("Then each has its own title", func() {
+				So(gone.Error.Title, ShouldEqual, "This page is no longer available")
+				So(unavailable.Error.Title, ShouldEqual, "Sorry, the service is unavailable")
+			})
+		})
+
+		Convey("When an error page is mapped for each status that a page can fail with", func() {
+			statuses := []int{
+				http.StatusUnauthorized,
+				http.StatusForbidden,
+				http.StatusNotFound,
+				http.StatusGone,
+				http.StatusInternalServerError,
+				http.StatusBadGateway,
+				http.StatusServiceUnavailable,
+				http.StatusGatewayTimeout,
+			}
+
+			Convey("Then each has its own localised title and description", func() {
+				for _, lang := range []string{"en", "cy"} {
+					titles := map[string]bool{}
+					for _, status := range statuses {
+						model := CreateErrorModel(basePage, status, lang, "", "", zebedee.EmergencyBanner{})
+						So(model.Error.Title, ShouldNotBeEmpty)
+						So(model.Error.Description, ShouldNotBeEmpty)
+						So(titles, ShouldNotContainKey, model.Error.Title)
+						titles[model.Error.Title] = true
+					}
+				}
+			})
+		})
+
+		Convey("When a Welsh error page is mapped", func() {
+			model := CreateErrorModel(basePage, http.StatusNotFound, "cy", "", "", zebedee.EmergencyBanner{})
+
+			Convey("Then the Welsh title is used", func() {
+				So(model.Error.Title, ShouldEqual, "Heb ddod o hyd i'r dudalen")
+			})
+		})
+
+		Convey("When an error page is mapped for a status without its own page", func() {
+			model := CreateErrorModel(basePage, http.StatusNotImplemented, "en", "", "", zebedee.EmergencyBanner{})
+
+			Convey("Then the internal server error text is used, keeping the status", func() {
+				So(model.StatusCode, ShouldEqual, http.StatusNotImplemented)
+				So(model.Error.Title, ShouldEqual, CreateErrorModel(basePage, http.StatusInternalServerError, "en", "", "", zebedee.EmergencyBanner{}).Error.Title)
+			})
+		})
+	})
+}
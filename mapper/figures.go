@@ -0,0 +1,71 @@
+package mapper
+
+import (
+	"strings"
+
+	"github.com/ONSdigital/dp-api-clients-go/v2/zebedee"
+)
+
+// Figure types, as used in the legacy <ons-*> markdown tags
+const (
+	FigureTypeChart    = "chart"
+	FigureTypeTable    = "table"
+	FigureTypeImage    = "image"
+	FigureTypeEquation = "equation"
+)
+
+// ViewFigure is an intermediate view to aid template rendering of a Figure
+type ViewFigure struct {
+	Figure
+	Language string
+}
+
+func mapFigure(f zebedee.Figure, figureType string) Figure {
+	figure := Figure{
+		Title:    f.Title,
+		Filename: f.Filename,
+		Version:  f.Version,
+		URI:      f.URI,
+		Type:     figureType,
+	}
+
+	switch figureType {
+	case FigureTypeChart:
+		figure.ImageURI = "/chartimage?uri=" + f.URI
+		figure.DownloadURI = "/file?uri=" + f.URI + ".xls"
+	case FigureTypeTable:
+		figure.DownloadURI = "/file?uri=" + f.URI + ".xls"
+	case FigureTypeImage:
+		figure.ImageURI = "/resource?uri=" + f.URI + ".png"
+		figure.DownloadURI = "/file?uri=" + f.URI + ".png"
+	case FigureTypeEquation:
+		figure.ImageURI = "/resource?uri=" + f.URI + ".svg"
+	}
+
+	return figure
+}
+
+func findFigure(model *BulletinModel, figureType, path string) (Figure, bool) {
+	var candidates []Figure
+	switch figureType {
+	case FigureTypeChart:
+		candidates = model.Charts
+	case FigureTypeTable:
+		candidates = model.Tables
+	case FigureTypeImage:
+		candidates = model.Images
+	case FigureTypeEquation:
+		candidates = model.Equations
+	}
+
+	for _, f := range candidates {
+		if normaliseFigurePath(f.URI) == normaliseFigurePath(path) {
+			return f, true
+		}
+	}
+	return Figure{}, false
+}
+
+func normaliseFigurePath(p string) string {
+	return strings.Trim(strings.TrimSuffix(p, ".json"), "/")
+}
@@ -0,0 +1,40 @@
+package mapper
+
+import (
+	"testing"
+
+	"github.com/ONSdigital/dp-api-clients-go/v2/zebedee"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestUnitFigures(t *testing.T) {
+	Convey("Given a zebedee figure", t, func() {
+		f := zebedee.Figure{
+			Title:    "chart 1",
+			Filename: "chart1",
+			Version:  "1",
+			URI:      "/a/bulletin/chart1",
+		}
+
+		Convey("mapFigure sets the image and download URIs for a chart", func() {
+			figure := mapFigure(f, FigureTypeChart)
+			So(figure.Title, ShouldEqual, f.Title)
+			So(figure.Type, ShouldEqual, FigureTypeChart)
+			So(figure.ImageURI, ShouldEqual, "/chartimage?uri=/a/bulletin/chart1")
+			So(figure.DownloadURI, ShouldEqual, "/file?uri=/a/bulletin/chart1.xls")
+		})
+
+		Convey("mapFigure sets only a download URI for a table", func() {
+			figure := mapFigure(f, FigureTypeTable)
+			So(figure.ImageURI, ShouldBeEmpty)
+			So(figure.DownloadURI, ShouldEqual, "/file?uri=/a/bulletin/chart1.xls")
+		})
+
+		Convey("mapFigure sets only an image URI for an equation", func() {
+			figure := mapFigure(f, FigureTypeEquation)
+			So(figure.ImageURI, ShouldEqual, "/resource?uri=/a/bulletin/chart1.svg")
+			So(figure.DownloadURI, ShouldBeEmpty)
+		})
+	})
+}
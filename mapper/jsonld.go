@@ -0,0 +1,161 @@
+package mapper
+
+import (
+	"encoding/json"
+	"html/template"
+)
+
+const (
+	schemaContext = "https://schema.org"
+	onsName       = "Office for National Statistics"
+	onsURL        = "https://www.ons.gov.uk"
+)
+
+// jsonLD is the structured data of a page for search engines, as a graph of schema.org items
+type jsonLD struct {
+	Context string        `json:"@context"`
+	Graph   []interface{} `json:"@graph"`
+}
+
+type jsonLDArticle struct {
+	Type          string             `json:"@type"`
+	ID            string             `json:"@id"`
+	Headline      string             `json:"headline"`
+	Description   string             `json:"description,omitempty"`
+	URL           string             `json:"url"`
+	InLanguage    string             `json:"inLanguage"`
+	DatePublished string             `json:"datePublished,omitempty"`
+	DateModified  string             `json:"dateModified,omitempty"`
+	Author        jsonLDOrganization `json:"author"`
+	Publisher     jsonLDOrganization `json:"publisher"`
+	Keywords      []string           `json:"keywords,omitempty"`
+}
+
+type jsonLDOrganization struct {
+	Type         string              `json:"@type"`
+	Name         string              `json:"name"`
+	URL          string              `json:"url"`
+	ContactPoint *jsonLDContactPoint `json:"contactPoint,omitempty"`
+}
+
+type jsonLDContactPoint struct {
+	Type      string `json:"@type"`
+	Name      string `json:"name,omitempty"`
+	Email     string `json:"email,omitempty"`
+	Telephone string `json:"telephone,omitempty"`
+}
+
+type jsonLDBreadcrumbList struct {
+	Type            string           `json:"@type"`
+	ItemListElement []jsonLDListItem `json:"itemListElement"`
+}
+
+type jsonLDListItem struct {
+	Type     string `json:"@type"`
+	Position int    `json:"position"`
+	Name     string `json:"name"`
+	Item     string `json:"item"`
+}
+
+type jsonLDDataset struct {
+	Type         string               `json:"@type"`
+	Name         string               `json:"name"`
+	Description  string               `json:"description,omitempty"`
+	URL          string               `json:"url"`
+	Creator      jsonLDOrganization   `json:"creator"`
+	Distribution []jsonLDDataDownload `json:"distribution"`
+}
+
+type jsonLDDataDownload struct {
+	Type           string `json:"@type"`
+	EncodingFormat string `json:"encodingFormat"`
+	ContentURL     string `json:"contentUrl"`
+}
+
+// createJSONLD creates the schema.org structured data of a bulletin or article page at currentUrl: the page itself as
+// a Report or Article, its breadcrumb trail, and its content as a Dataset that can be downloaded from the /data
+// endpoint. It is marshalled with HTML characters escaped, so that it can be rendered in a script element.
+func createJSONLD(model BulletinModel, requestProtocol, currentUrl string) template.JS {
+	ons := jsonLDOrganization{Type: "Organization", Name: onsName, URL: onsURL}
+
+	description := model.Metadata.Description
+	if description == "" {
+		description = model.Summary
+	}
+
+	article := jsonLDArticle{
+		Type:          "Article",
+		ID:            currentUrl,
+		Headline:      model.Metadata.Title,
+		Description:   description,
+		URL:           currentUrl,
+		InLanguage:    model.Language,
+		DatePublished: model.ReleaseDate,
+		DateModified:  getDateModified(model),
+		Author:        ons,
+		Publisher:     ons,
+		Keywords:      model.Metadata.Keywords,
+	}
+	if model.Type == "bulletin" {
+		article.Type = "Report"
+	}
+	if model.Contact != (Contact{}) {
+		article.Author.ContactPoint = &jsonLDContactPoint{
+			Type:      "ContactPoint",
+			Name:      model.Contact.Name,
+			Email:     model.Contact.Email,
+			Telephone: model.Contact.Telephone,
+		}
+	}
+
+	breadcrumbs := jsonLDBreadcrumbList{Type: "BreadcrumbList", ItemListElement: []jsonLDListItem{}}
+	for i, node := range model.Page.Breadcrumb {
+		breadcrumbs.ItemListElement = append(breadcrumbs.ItemListElement, jsonLDListItem{
+			Type:     "ListItem",
+			Position: i + 1,
+			Name:     node.Title,
+			Item:     getCurrentUrl(requestProtocol, model.SiteDomain, node.URI, model.Language),
+		})
+	}
+	breadcrumbs.ItemListElement = append(breadcrumbs.ItemListElement, jsonLDListItem{
+		Type:     "ListItem",
+		Position: len(breadcrumbs.ItemListElement) + 1,
+		Name:     model.Metadata.Title,
+		Item:     currentUrl,
+	})
+
+	dataset := jsonLDDataset{
+		Type:        "Dataset",
+		Name:        model.Metadata.Title,
+		Description: description,
+		URL:         currentUrl,
+		Creator:     ons,
+		Distribution: []jsonLDDataDownload{{
+			Type:           "DataDownload",
+			EncodingFormat: "application/json",
+			ContentURL:     currentUrl + "/data",
+		}},
+	}
+
+	// json.Marshal escapes <, > and &, so the content cannot close the script element that it is rendered in. None of
+	// the types above can fail to marshal.
+	b, err := json.Marshal(jsonLD{
+		Context: schemaContext,
+		Graph:   []interface{}{article, breadcrumbs, dataset},
+	})
+	if err != nil {
+		return ""
+	}
+	return template.JS(b)
+}
+
+// getDateModified returns the date of the latest version of the content, or its release date if it has not been
+// revised. Versions are sorted with the latest first.
+func getDateModified(model BulletinModel) string {
+	for _, v := range model.Versions {
+		if v.Date != "" && isValidDate(v.Date) {
+			return v.Date
+		}
+	}
+	return model.ReleaseDate
+}
@@ -0,0 +1,174 @@
+package mapper
+
+import (
+	"bytes"
+	"encoding/json"
+	"html/template"
+	"strings"
+	"testing"
+
+	"github.com/ONSdigital/dp-api-clients-go/v2/articles"
+	"github.com/ONSdigital/dp-api-clients-go/v2/zebedee"
+	"github.com/ONSdigital/dp-frontend-articles-controller/mocks"
+	"github.com/ONSdigital/dp-renderer/helper"
+	coreModel "github.com/ONSdigital/dp-renderer/model"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestUnitJSONLD(t *testing.T) {
+	helper.InitialiseLocalisationsHelper(mocks.MockAssetFunction)
+
+	Convey("Given a bulletin that has been revised", t, func() {
+		bulletin := articles.Bulletin{
+			URI:  "/economy/grossdomesticproductgdp/bulletins/gdpmonthlyestimateuk/june2022",
+			Type: "bulletin",
+			Description: zebedee.Description{
+				Title:           "GDP monthly estimate, UK",
+				Summary:         "Monthly gross domestic product (GDP) estimates.",
+				MetaDescription: "Monthly GDP estimates for the UK.",
+				Keywords:        []string{"gdp", "economy"},
+				ReleaseDate:     "2022-08-12T06:00:00.000Z",
+				Contact: zebedee.Contact{
+					Name:      "GDP team",
+					Email:     "gdp@ons.gov.uk",
+					Telephone: "+44 1633 456721",
+				},
+			},
+			Versions: []zebedee.Version{
+				{URI: "/economy/grossdomesticproductgdp/bulletins/gdpmonthlyestimateuk/june2022/previous/v1", ReleaseDate: "2022-08-15T09:30:00.000Z"},
+				{URI: "/economy/grossdomesticproductgdp/bulletins/gdpmonthlyestimateuk/june2022/previous/v2", ReleaseDate: "2022-08-19T09:30:00.000Z"},
+			},
+		}
+		bcs := []zebedee.Breadcrumb{
+			{URI: "/", Description: zebedee.NodeDescription{Title: "Home"}},
+			{URI: "/economy", Description: zebedee.NodeDescription{Title: "Economy"}},
+		}
+		basePage := coreModel.NewPage("path/to/assets", "ons.gov.uk")
+
+		Convey("When it is mapped", func() {
+			model, err := CreateBulletinModel(basePage, bulletin, bcs, "en", "https", "", zebedee.EmergencyBanner{}, "")
+			So(err, ShouldBeNil)
+
+			var ld map[string]interface{}
+			So(json.Unmarshal([]byte(model.JSONLD), &ld), ShouldBeNil)
+			So(ld["@context"], ShouldEqual, "https://schema.org")
+			graph := ld["@graph"].([]interface{})
+			So(graph, ShouldHaveLength, 3)
+			pageURL := "https://ons.gov.uk/economy/grossdomesticproductgdp/bulletins/gdpmonthlyestimateuk/june2022"
+
+			Convey("Then it is described as a report published by the ONS", func() {
+				report := graph[0].(map[string]interface{})
+				So(report["@type"], ShouldEqual, "Report")
+				So(report["@id"], ShouldEqual, pageURL)
+				So(report["url"], ShouldEqual, pageURL)
+				So(report["headline"], ShouldEqual, "GDP monthly estimate, UK")
+				So(report["description"], ShouldEqual, "Monthly GDP estimates for the UK.")
+				So(report["inLanguage"], ShouldEqual, "en")
+				So(report["datePublished"], ShouldEqual, "2022-08-12T06:00:00.000Z")
+				So(report["dateModified"], ShouldEqual, "2022-08-19T09:30:00.000Z")
+				So(report["keywords"], ShouldResemble, []interface{}{"gdp", "economy"})
+				So(report["publisher"], ShouldResemble, map[string]interface{}{
+					"@type": "Organization",
+					"name":  "Office for National Statistics",
+					"url":   "https://www.ons.gov.uk",
+				})
+				So(report["author"], ShouldResemble, map[string]interface{}{
+					"@type": "Organization",
+					"name":  "Office for National Statistics",
+					"url":   "https://www.ons.gov.uk",
+					"contactPoint": map[string]interface{}{
+						"@type":     "ContactPoint",
+						"name":      "GDP team",
+						"email":     "gdp@ons.gov.uk",
+						"telephone": "+44 1633 456721",
+					},
+				})
+			})
+
+			Convey("Then its breadcrumb trail ends with the page", func() {
+				So(graph[1], ShouldResemble, map[string]interface{}{
+					"@type": "BreadcrumbList",
+					"itemListElement": []interface{}{
+						map[string]interface{}{"@type": "ListItem", "position": float64(1), "name": "Home", "item": "https://ons.gov.uk/"},
+						map[string]interface{}{"@type": "ListItem", "position": float64(2), "name": "Economy", "item": "https://ons.gov.uk/economy"},
+						map[string]interface{}{"@type": "ListItem", "position": float64(3), "name": "GDP monthly estimate, UK", "item": pageURL},
+					},
+				})
+			})
+
+			Convey("Then its content can be downloaded as a dataset from the data endpoint", func() {
+				dataset := graph[2].(map[string]interface{})
+				So(dataset["@type"], ShouldEqual, "Dataset")
+				So(dataset["name"], ShouldEqual, "GDP monthly estimate, UK")
+				So(dataset["url"], ShouldEqual, pageURL)
+				So(dataset["distribution"], ShouldResemble, []interface{}{
+					map[string]interface{}{
+						"@type":          "DataDownload",
+						"encodingFormat": "application/json",
+						"contentUrl":     pageURL + "/data",
+					},
+				})
+			})
+		})
+
+		Convey("When it is mapped in Welsh", func() {
+			model, err := CreateBulletinModel(basePage, bulletin, bcs, "cy", "https", "", zebedee.EmergencyBanner{}, "")
+			So(err, ShouldBeNil)
+
+			Convey("Then the URLs are on the Welsh site", func() {
+				So(string(model.JSONLD), ShouldContainSubstring, `"url":"https://cy.ons.gov.uk/economy/grossdomesticproductgdp/bulletins/gdpmonthlyestimateuk/june2022"`)
+				So(string(model.JSONLD), ShouldContainSubstring, `"inLanguage":"cy"`)
+			})
+		})
+
+		Convey("When an article without a contact, meta description or revisions is mapped", func() {
+			bulletin.Type = "article"
+			bulletin.Description.Contact = zebedee.Contact{}
+			bulletin.Description.MetaDescription = ""
+			bulletin.Versions = nil
+
+			model, err := CreateBulletinModel(basePage, bulletin, bcs, "en", "https", "", zebedee.EmergencyBanner{}, "")
+			So(err, ShouldBeNil)
+
+			var ld struct {
+				Graph []map[string]interface{} `json:"@graph"`
+			}
+			So(json.Unmarshal([]byte(model.JSONLD), &ld), ShouldBeNil)
+
+			Convey("Then it is described as an article, modified when it was published", func() {
+				article := ld.Graph[0]
+				So(article["@type"], ShouldEqual, "Article")
+				So(article["description"], ShouldEqual, "Monthly gross domestic product (GDP) estimates.")
+				So(article["dateModified"], ShouldEqual, "2022-08-12T06:00:00.000Z")
+				So(article["author"], ShouldNotContainKey, "contactPoint")
+			})
+		})
+
+		Convey("When its content would close the script element that it is rendered in", func() {
+			bulletin.Description.Title = `GDP </script><script>alert("x & y")</script>`
+
+			model, err := CreateBulletinModel(basePage, bulletin, bcs, "en", "https", "", zebedee.EmergencyBanner{}, "")
+			So(err, ShouldBeNil)
+
+			tmpl := template.Must(template.New("json-ld").Parse(`<script type="application/ld+json">{{ .JSONLD }}</script>`))
+			var b bytes.Buffer
+			So(tmpl.Execute(&b, model), ShouldBeNil)
+
+			Convey("Then the HTML characters are escaped", func() {
+				So(string(model.JSONLD), ShouldNotContainSubstring, "<")
+				So(string(model.JSONLD), ShouldNotContainSubstring, ">")
+				So(string(model.JSONLD), ShouldNotContainSubstring, "&")
+				So(strings.Count(b.String(), "</script>"), ShouldEqual, 1)
+			})
+
+			Convey("Then it is still valid JSON with the original title", func() {
+				var ld struct {
+					Graph []map[string]interface{} `json:"@graph"`
+				}
+				content := strings.TrimSuffix(strings.TrimPrefix(b.String(), `<script type="application/ld+json">`), "</script>")
+				So(json.Unmarshal([]byte(content), &ld), ShouldBeNil)
+				So(ld.Graph[0]["headline"], ShouldEqual, bulletin.Description.Title)
+			})
+		})
+	})
+}
@@ -17,33 +17,36 @@ type ShareLinks map[string]coreModel.ShareLink
 
 type BulletinModel struct {
 	coreModel.Page
-	Summary           string        `json:"summary"`
-	Sections          []Section     `json:"sections"`
-	Accordion         []Section     `json:"accordion"`
-	Charts            []Figure      `json:"charts"`
-	Tables            []Figure      `json:"tables"`
-	Images            []Figure      `json:"images"`
-	Equations         []Figure      `json:"equations"`
-	RelatedBulletins  []Link        `json:"relatedBulletins"`
-	RelatedData       []Link        `json:"relatedData"`
-	Links             []Link        `json:"links"`
-	URI               string        `json:"uri"`
-	NationalStatistic bool          `json:"nationalStatistic"`
-	LatestRelease     bool          `json:"latestRelease"`
-	Edition           string        `json:"edition"`
-	ReleaseDate       string        `json:"releaseDate"`
-	NextRelease       string        `json:"nextRelease"`
-	Contact           Contact       `json:"contact"`
-	Versions          []Message     `json:"versions"`
-	Alerts            []Message     `json:"alerts"`
-	ParentPath        string        `json:"parentPath"`
-	CorrectedPath     string        `json:"correctedPath"`
-	LatestReleaseUri  string        `json:"latestReleaseUri"`
-	ContentsView      []ViewSection `json:"contentsView"`
-	ShareLinks        ShareLinks    `json:"shareLinks"`
-	Census2021        bool          `json:"census_2021"`
-	AboutTheData      bool          `json:"about_the_data"`
-	Auxiliary         []Section     `json:"auxiliary"`
+	Summary           string            `json:"summary"`
+	Sections          []Section         `json:"sections"`
+	Accordion         []Section         `json:"accordion"`
+	Charts            []Figure          `json:"charts"`
+	Tables            []Figure          `json:"tables"`
+	Images            []Figure          `json:"images"`
+	Equations         []Figure          `json:"equations"`
+	RelatedBulletins  []Link            `json:"relatedBulletins"`
+	RelatedData       []Link            `json:"relatedData"`
+	Links             []Link            `json:"links"`
+	URI               string            `json:"uri"`
+	NationalStatistic bool              `json:"nationalStatistic"`
+	LatestRelease     bool              `json:"latestRelease"`
+	Edition           string            `json:"edition"`
+	ReleaseDate       string            `json:"releaseDate"`
+	NextRelease       string            `json:"nextRelease"`
+	Contact           Contact           `json:"contact"`
+	Versions          []Message         `json:"versions"`
+	Alerts            []Message         `json:"alerts"`
+	ParentPath        string            `json:"parentPath"`
+	CorrectedPath     string            `json:"correctedPath"`
+	LatestReleaseUri  string            `json:"latestReleaseUri"`
+	ContentsView      []ViewSection     `json:"contentsView"`
+	ShareLinks        ShareLinks        `json:"shareLinks"`
+	Census2021        bool              `json:"census_2021"`
+	AboutTheData      bool              `json:"about_the_data"`
+	Auxiliary         []Section         `json:"auxiliary"`
+	UnresolvedFigures []FigureReference `json:"unresolvedFigures"`
+	JSONLD            template.JS       `json:"jsonLd"`
+	SocialMetadata    SocialMetadata    `json:"socialMetadata"`
 }
 
 // Intermediate view to aid template rendering of Sections and Accordion
@@ -54,6 +57,7 @@ type ViewSection struct {
 	Index    int
 	BackTo   coreModel.BackTo
 	Language string
+	Blocks   []ContentBlock
 }
 
 type Contact struct {
@@ -68,10 +72,13 @@ type Link struct {
 }
 
 type Figure struct {
-	Title    string `json:"title"`
-	Filename string `json:"filename"`
-	Version  string `json:"version"`
-	URI      string `json:"uri"`
+	Title       string `json:"title"`
+	Filename    string `json:"filename"`
+	Version     string `json:"version"`
+	URI         string `json:"uri"`
+	Type        string `json:"type"`
+	ImageURI    string `json:"imageUri"`
+	DownloadURI string `json:"downloadUri"`
 }
 
 type Section struct {
@@ -85,7 +92,13 @@ type Message struct {
 	URI      string `json:"uri"`
 }
 
-func CreateSixteensBulletinModel(basePage coreModel.Page, bulletin articles.Bulletin, bcs []zebedee.Breadcrumb, lang string) BulletinModel {
+// CreateSixteensBulletinModel maps a bulletin to the legacy sixteens page. A *ValidationError is returned if the
+// bulletin is too malformed to be mapped.
+func CreateSixteensBulletinModel(basePage coreModel.Page, bulletin articles.Bulletin, bcs []zebedee.Breadcrumb, lang string) (BulletinModel, error) {
+	if err := validateBulletin(bulletin); err != nil {
+		return BulletinModel{}, err
+	}
+
 	model := BulletinModel{
 		Page: basePage,
 	}
@@ -154,39 +167,19 @@ func CreateSixteensBulletinModel(basePage coreModel.Page, bulletin articles.Bull
 	}
 	model.Charts = []Figure{}
 	for _, s := range bulletin.Charts {
-		model.Charts = append(model.Charts, Figure{
-			Title:    s.Title,
-			Filename: s.Filename,
-			Version:  s.Version,
-			URI:      s.URI,
-		})
+		model.Charts = append(model.Charts, mapFigure(s, FigureTypeChart))
 	}
 	model.Tables = []Figure{}
 	for _, s := range bulletin.Tables {
-		model.Tables = append(model.Tables, Figure{
-			Title:    s.Title,
-			Filename: s.Filename,
-			Version:  s.Version,
-			URI:      s.URI,
-		})
+		model.Tables = append(model.Tables, mapFigure(s, FigureTypeTable))
 	}
 	model.Images = []Figure{}
 	for _, s := range bulletin.Images {
-		model.Images = append(model.Images, Figure{
-			Title:    s.Title,
-			Filename: s.Filename,
-			Version:  s.Version,
-			URI:      s.URI,
-		})
+		model.Images = append(model.Images, mapFigure(s, FigureTypeImage))
 	}
 	model.Equations = []Figure{}
 	for _, s := range bulletin.Equations {
-		model.Equations = append(model.Equations, Figure{
-			Title:    s.Title,
-			Filename: s.Filename,
-			Version:  s.Version,
-			URI:      s.URI,
-		})
+		model.Equations = append(model.Equations, mapFigure(s, FigureTypeEquation))
 	}
 
 	model.Versions = []Message{}
@@ -215,7 +208,7 @@ func CreateSixteensBulletinModel(basePage coreModel.Page, bulletin articles.Bull
 		})
 	}
 
-	return model
+	return model, nil
 }
 
 func mapEmergencyBanner(bannerData zebedee.EmergencyBanner) coreModel.EmergencyBanner {
@@ -260,7 +253,14 @@ func createPreGTMJavaScript(title string, description BulletinModel) []template.
 	}
 }
 
-func CreateBulletinModel(basePage coreModel.Page, bulletin articles.Bulletin, bcs []zebedee.Breadcrumb, lang, requestProtocol, serviceMessage string, emergencyBannerContent zebedee.EmergencyBanner) BulletinModel {
+// CreateBulletinModel maps a bulletin to its page. socialImageURL is the image of the preview shown when a link to the
+// page is shared, unless the bulletin has an image or chart of its own. A *ValidationError is returned if the bulletin
+// is too malformed to be mapped.
+func CreateBulletinModel(basePage coreModel.Page, bulletin articles.Bulletin, bcs []zebedee.Breadcrumb, lang, requestProtocol, serviceMessage string, emergencyBannerContent zebedee.EmergencyBanner, socialImageURL string) (BulletinModel, error) {
+	if err := validateBulletin(bulletin); err != nil {
+		return BulletinModel{}, err
+	}
+
 	model := BulletinModel{
 		Page: basePage,
 	}
@@ -333,39 +333,19 @@ func CreateBulletinModel(basePage coreModel.Page, bulletin articles.Bulletin, bc
 	}
 	model.Charts = []Figure{}
 	for _, s := range bulletin.Charts {
-		model.Charts = append(model.Charts, Figure{
-			Title:    s.Title,
-			Filename: s.Filename,
-			Version:  s.Version,
-			URI:      s.URI,
-		})
+		model.Charts = append(model.Charts, mapFigure(s, FigureTypeChart))
 	}
 	model.Tables = []Figure{}
 	for _, s := range bulletin.Tables {
-		model.Tables = append(model.Tables, Figure{
-			Title:    s.Title,
-			Filename: s.Filename,
-			Version:  s.Version,
-			URI:      s.URI,
-		})
+		model.Tables = append(model.Tables, mapFigure(s, FigureTypeTable))
 	}
 	model.Images = []Figure{}
 	for _, s := range bulletin.Images {
-		model.Images = append(model.Images, Figure{
-			Title:    s.Title,
-			Filename: s.Filename,
-			Version:  s.Version,
-			URI:      s.URI,
-		})
+		model.Images = append(model.Images, mapFigure(s, FigureTypeImage))
 	}
 	model.Equations = []Figure{}
 	for _, s := range bulletin.Equations {
-		model.Equations = append(model.Equations, Figure{
-			Title:    s.Title,
-			Filename: s.Filename,
-			Version:  s.Version,
-			URI:      s.URI,
-		})
+		model.Equations = append(model.Equations, mapFigure(s, FigureTypeEquation))
 	}
 
 	model.Versions = []Message{}
@@ -393,7 +373,24 @@ func CreateBulletinModel(basePage coreModel.Page, bulletin articles.Bulletin, bc
 	currentUrl := getCurrentUrl(requestProtocol, model.SiteDomain, model.URI, lang)
 	model.ShareLinks = createShareLinks(model.Metadata.Title, currentUrl)
 	model.PreGTMJavaScript = createPreGTMJavaScript(model.Metadata.Title, model)
-	return model
+	model.JSONLD = createJSONLD(model, requestProtocol, currentUrl)
+	model.SocialMetadata = createSocialMetadata(model, requestProtocol, currentUrl, socialImageURL)
+	return model, nil
+}
+
+// ArticleModel is the page model for an article, built on the fields it shares with a bulletin
+type ArticleModel struct {
+	BulletinModel
+}
+
+// CreateArticleModel maps an article to its page. A *ValidationError is returned if the article is too malformed to
+// be mapped.
+func CreateArticleModel(basePage coreModel.Page, article articles.Bulletin, bcs []zebedee.Breadcrumb, lang, requestProtocol, serviceMessage string, emergencyBannerContent zebedee.EmergencyBanner, socialImageURL string) (ArticleModel, error) {
+	bulletinModel, err := CreateBulletinModel(basePage, article, bcs, lang, requestProtocol, serviceMessage, emergencyBannerContent, socialImageURL)
+	if err != nil {
+		return ArticleModel{}, err
+	}
+	return ArticleModel{BulletinModel: bulletinModel}, nil
 }
 
 // Sections are always followed by Accordions
@@ -422,6 +419,9 @@ func populateContents(model *BulletinModel) {
 			AnchorFragment: "toc",
 		}
 		views[index].Language = model.Language
+		var unresolved []FigureReference
+		views[index].Blocks, unresolved = preprocessMarkdown(views[index].Id, (*views[index].Source)[views[index].Index].Markdown, model)
+		model.UnresolvedFigures = append(model.UnresolvedFigures, unresolved...)
 	}
 
 	if model.AboutTheData {
@@ -509,8 +509,13 @@ func createTableOfContents(views []ViewSection) coreModel.TableOfContents {
 	return toc
 }
 
+// parentPath returns the path that p is in, or "" if p is not in a path
 func parentPath(p string) string {
-	return p[:strings.LastIndex(p, "/")]
+	i := strings.LastIndex(p, "/")
+	if i < 0 {
+		return ""
+	}
+	return p[:i]
 }
 
 type SectionReference struct {
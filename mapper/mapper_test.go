@@ -201,11 +201,12 @@ func TestUnitMapper(t *testing.T) {
 			bulletin.Description.Survey = "census"
 
 			Convey("And the bulletin URI is not a previous version", func() {
-				bulletin.URI = "the/bulletin/uri/path/version"
+				bulletin.URI = "/the/bulletin/uri/path/version"
 
 				Convey("CreateBulletinModel maps correctly", func() {
 					requestProtocol := "https"
-					model := CreateBulletinModel(basePage, bulletin, breadcrumbs, "cy", requestProtocol, serviceMessage, bannerData)
+					model, err := CreateBulletinModel(basePage, bulletin, breadcrumbs, "cy", requestProtocol, serviceMessage, bannerData, "")
+					So(err, ShouldBeNil)
 
 					So(model.Page.PatternLibraryAssetsPath, ShouldEqual, basePage.PatternLibraryAssetsPath)
 					So(model.Page.SiteDomain, ShouldEqual, basePage.SiteDomain)
@@ -223,7 +224,7 @@ func TestUnitMapper(t *testing.T) {
 					So(model.Summary, ShouldEqual, bulletin.Description.Summary)
 					So(model.Type, ShouldEqual, bulletin.Type)
 					So(model.URI, ShouldEqual, bulletin.URI)
-					So(model.ParentPath, ShouldEqual, "the/bulletin/uri/path")
+					So(model.ParentPath, ShouldEqual, "/the/bulletin/uri/path")
 					So(model.CorrectedPath, ShouldBeEmpty)
 					So(model.Edition, ShouldEqual, bulletin.Description.Edition)
 					So(model.NationalStatistic, ShouldEqual, bulletin.Description.NationalStatistic)
@@ -280,7 +281,8 @@ func TestUnitMapper(t *testing.T) {
 				})
 
 				Convey("CreateSixteensBulletinModel maps correctly", func() {
-					model := CreateSixteensBulletinModel(basePage, bulletin, breadcrumbs, "cy")
+					model, err := CreateSixteensBulletinModel(basePage, bulletin, breadcrumbs, "cy")
+					So(err, ShouldBeNil)
 
 					So(model.Page.PatternLibraryAssetsPath, ShouldEqual, basePage.PatternLibraryAssetsPath)
 					So(model.FeatureFlags.SixteensVersion, ShouldEqual, "67f6982")
@@ -291,7 +293,7 @@ func TestUnitMapper(t *testing.T) {
 					So(model.Summary, ShouldEqual, bulletin.Description.Summary)
 					So(model.Type, ShouldEqual, bulletin.Type)
 					So(model.URI, ShouldEqual, bulletin.URI)
-					So(model.ParentPath, ShouldEqual, "the/bulletin/uri/path")
+					So(model.ParentPath, ShouldEqual, "/the/bulletin/uri/path")
 					So(model.CorrectedPath, ShouldBeEmpty)
 					So(model.Edition, ShouldEqual, bulletin.Description.Edition)
 					So(model.NationalStatistic, ShouldEqual, bulletin.Description.NationalStatistic)
@@ -336,10 +338,11 @@ func TestUnitMapper(t *testing.T) {
 				})
 			})
 			Convey("And the bulletin URI is a previous version", func() {
-				bulletin.URI = "the/bulletin/uri/path/previous/version"
+				bulletin.URI = "/the/bulletin/uri/path/previous/version"
 				Convey("CreateBulletinModel maps correctly", func() {
 					requestProtocol := "https"
-					model := CreateBulletinModel(basePage, bulletin, breadcrumbs, "cy", requestProtocol, serviceMessage, bannerData)
+					model, err := CreateBulletinModel(basePage, bulletin, breadcrumbs, "cy", requestProtocol, serviceMessage, bannerData, "")
+					So(err, ShouldBeNil)
 
 					So(model.Page.PatternLibraryAssetsPath, ShouldEqual, basePage.PatternLibraryAssetsPath)
 					So(model.Page.SiteDomain, ShouldEqual, basePage.SiteDomain)
@@ -355,8 +358,8 @@ func TestUnitMapper(t *testing.T) {
 					So(model.Metadata.Keywords, ShouldResemble, bulletin.Description.Keywords)
 					So(model.Type, ShouldEqual, bulletin.Type)
 					So(model.URI, ShouldEqual, bulletin.URI)
-					So(model.ParentPath, ShouldEqual, "the/bulletin/uri/path/previous")
-					So(model.CorrectedPath, ShouldEqual, "the/bulletin/uri/path")
+					So(model.ParentPath, ShouldEqual, "/the/bulletin/uri/path/previous")
+					So(model.CorrectedPath, ShouldEqual, "/the/bulletin/uri/path")
 					So(model.Edition, ShouldEqual, bulletin.Description.Edition)
 					So(model.NationalStatistic, ShouldEqual, bulletin.Description.NationalStatistic)
 					So(model.ReleaseDate, ShouldEqual, bulletin.Description.ReleaseDate)
@@ -412,7 +415,8 @@ func TestUnitMapper(t *testing.T) {
 				})
 
 				Convey("CreateSixteensBulletinModel maps correctly", func() {
-					model := CreateSixteensBulletinModel(basePage, bulletin, breadcrumbs, "cy")
+					model, err := CreateSixteensBulletinModel(basePage, bulletin, breadcrumbs, "cy")
+					So(err, ShouldBeNil)
 
 					So(model.Page.PatternLibraryAssetsPath, ShouldEqual, basePage.PatternLibraryAssetsPath)
 					So(model.FeatureFlags.SixteensVersion, ShouldEqual, "67f6982")
@@ -422,8 +426,8 @@ func TestUnitMapper(t *testing.T) {
 					So(model.Metadata.Keywords, ShouldResemble, bulletin.Description.Keywords)
 					So(model.Type, ShouldEqual, bulletin.Type)
 					So(model.URI, ShouldEqual, bulletin.URI)
-					So(model.ParentPath, ShouldEqual, "the/bulletin/uri/path/previous")
-					So(model.CorrectedPath, ShouldEqual, "the/bulletin/uri/path")
+					So(model.ParentPath, ShouldEqual, "/the/bulletin/uri/path/previous")
+					So(model.CorrectedPath, ShouldEqual, "/the/bulletin/uri/path")
 					So(model.Edition, ShouldEqual, bulletin.Description.Edition)
 					So(model.NationalStatistic, ShouldEqual, bulletin.Description.NationalStatistic)
 					So(model.ReleaseDate, ShouldEqual, bulletin.Description.ReleaseDate)
@@ -472,11 +476,12 @@ func TestUnitMapper(t *testing.T) {
 			bulletin.Description.Survey = "other"
 
 			Convey("And the bulletin URI is not a previous version", func() {
-				bulletin.URI = "the/bulletin/uri/path/version"
+				bulletin.URI = "/the/bulletin/uri/path/version"
 
 				Convey("CreateBulletinModel maps correctly", func() {
 					requestProtocol := "https"
-					model := CreateBulletinModel(basePage, bulletin, breadcrumbs, "cy", requestProtocol, serviceMessage, bannerData)
+					model, err := CreateBulletinModel(basePage, bulletin, breadcrumbs, "cy", requestProtocol, serviceMessage, bannerData, "")
+					So(err, ShouldBeNil)
 
 					So(model.Page.PatternLibraryAssetsPath, ShouldEqual, basePage.PatternLibraryAssetsPath)
 					So(model.Page.SiteDomain, ShouldEqual, basePage.SiteDomain)
@@ -494,7 +499,7 @@ func TestUnitMapper(t *testing.T) {
 					So(model.Summary, ShouldEqual, bulletin.Description.Summary)
 					So(model.Type, ShouldEqual, bulletin.Type)
 					So(model.URI, ShouldEqual, bulletin.URI)
-					So(model.ParentPath, ShouldEqual, "the/bulletin/uri/path")
+					So(model.ParentPath, ShouldEqual, "/the/bulletin/uri/path")
 					So(model.CorrectedPath, ShouldBeEmpty)
 					So(model.Edition, ShouldEqual, bulletin.Description.Edition)
 					So(model.NationalStatistic, ShouldEqual, bulletin.Description.NationalStatistic)
@@ -551,7 +556,8 @@ func TestUnitMapper(t *testing.T) {
 				})
 
 				Convey("CreateSixteensBulletinModel maps correctly", func() {
-					model := CreateSixteensBulletinModel(basePage, bulletin, breadcrumbs, "cy")
+					model, err := CreateSixteensBulletinModel(basePage, bulletin, breadcrumbs, "cy")
+					So(err, ShouldBeNil)
 
 					So(model.Page.PatternLibraryAssetsPath, ShouldEqual, basePage.PatternLibraryAssetsPath)
 					So(model.FeatureFlags.SixteensVersion, ShouldEqual, "67f6982")
@@ -562,7 +568,7 @@ func TestUnitMapper(t *testing.T) {
 					So(model.Summary, ShouldEqual, bulletin.Description.Summary)
 					So(model.Type, ShouldEqual, bulletin.Type)
 					So(model.URI, ShouldEqual, bulletin.URI)
-					So(model.ParentPath, ShouldEqual, "the/bulletin/uri/path")
+					So(model.ParentPath, ShouldEqual, "/the/bulletin/uri/path")
 					So(model.CorrectedPath, ShouldBeEmpty)
 					So(model.Edition, ShouldEqual, bulletin.Description.Edition)
 					So(model.NationalStatistic, ShouldEqual, bulletin.Description.NationalStatistic)
@@ -607,10 +613,11 @@ func TestUnitMapper(t *testing.T) {
 				})
 			})
 			Convey("When the bulletin URI is a previous version", func() {
-				bulletin.URI = "the/bulletin/uri/path/previous/version"
+				bulletin.URI = "/the/bulletin/uri/path/previous/version"
 				Convey("CreateBulletinModel maps correctly", func() {
 					requestProtocol := "https"
-					model := CreateBulletinModel(basePage, bulletin, breadcrumbs, "cy", requestProtocol, serviceMessage, bannerData)
+					model, err := CreateBulletinModel(basePage, bulletin, breadcrumbs, "cy", requestProtocol, serviceMessage, bannerData, "")
+					So(err, ShouldBeNil)
 
 					So(model.Page.PatternLibraryAssetsPath, ShouldEqual, basePage.PatternLibraryAssetsPath)
 					So(model.Page.SiteDomain, ShouldEqual, basePage.SiteDomain)
@@ -626,8 +633,8 @@ func TestUnitMapper(t *testing.T) {
 					So(model.Metadata.Keywords, ShouldResemble, bulletin.Description.Keywords)
 					So(model.Type, ShouldEqual, bulletin.Type)
 					So(model.URI, ShouldEqual, bulletin.URI)
-					So(model.ParentPath, ShouldEqual, "the/bulletin/uri/path/previous")
-					So(model.CorrectedPath, ShouldEqual, "the/bulletin/uri/path")
+					So(model.ParentPath, ShouldEqual, "/the/bulletin/uri/path/previous")
+					So(model.CorrectedPath, ShouldEqual, "/the/bulletin/uri/path")
 					So(model.Edition, ShouldEqual, bulletin.Description.Edition)
 					So(model.NationalStatistic, ShouldEqual, bulletin.Description.NationalStatistic)
 					So(model.ReleaseDate, ShouldEqual, bulletin.Description.ReleaseDate)
@@ -683,7 +690,8 @@ func TestUnitMapper(t *testing.T) {
 				})
 
 				Convey("CreateSixteensBulletinModel maps correctly", func() {
-					model := CreateSixteensBulletinModel(basePage, bulletin, breadcrumbs, "cy")
+					model, err := CreateSixteensBulletinModel(basePage, bulletin, breadcrumbs, "cy")
+					So(err, ShouldBeNil)
 
 					So(model.Page.PatternLibraryAssetsPath, ShouldEqual, basePage.PatternLibraryAssetsPath)
 					So(model.FeatureFlags.SixteensVersion, ShouldEqual, "67f6982")
@@ -693,8 +701,8 @@ func TestUnitMapper(t *testing.T) {
 					So(model.Metadata.Keywords, ShouldResemble, bulletin.Description.Keywords)
 					So(model.Type, ShouldEqual, bulletin.Type)
 					So(model.URI, ShouldEqual, bulletin.URI)
-					So(model.ParentPath, ShouldEqual, "the/bulletin/uri/path/previous")
-					So(model.CorrectedPath, ShouldEqual, "the/bulletin/uri/path")
+					So(model.ParentPath, ShouldEqual, "/the/bulletin/uri/path/previous")
+					So(model.CorrectedPath, ShouldEqual, "/the/bulletin/uri/path")
 					So(model.Edition, ShouldEqual, bulletin.Description.Edition)
 					So(model.NationalStatistic, ShouldEqual, bulletin.Description.NationalStatistic)
 					So(model.ReleaseDate, ShouldEqual, bulletin.Description.ReleaseDate)
@@ -741,6 +749,40 @@ func TestUnitMapper(t *testing.T) {
 	})
 }
 
+func TestUnitArticleMapper(t *testing.T) {
+	helper.InitialiseLocalisationsHelper(mocks.MockAssetFunction)
+
+	Convey("Given an article", t, func() {
+		article := articles.Bulletin{
+			Type: "article",
+			URI:  "/the/article/uri",
+			Description: zebedee.Description{
+				Title:   "Article title",
+				Summary: "summary",
+			},
+			Sections: []zebedee.Section{
+				{
+					Title:    "section1",
+					Markdown: "markdown1",
+				},
+			},
+		}
+
+		Convey("CreateArticleModel maps the fields shared with bulletins", func() {
+			model, err := CreateArticleModel(coreModel.NewPage("path/to/assets", "site-domain"), article, []zebedee.Breadcrumb{}, "en", "https", "", zebedee.EmergencyBanner{}, "")
+			So(err, ShouldBeNil)
+
+			So(model.Type, ShouldEqual, "article")
+			So(model.URI, ShouldEqual, article.URI)
+			So(model.Metadata.Title, ShouldEqual, article.Description.Title)
+			So(model.Summary, ShouldEqual, article.Description.Summary)
+			So(model.ParentPath, ShouldEqual, "/the/article")
+			assertSections(model.Sections, article.Sections)
+			assertContentsView(model.ContentsView, article.Sections, article.Accordion, false)
+		})
+	})
+}
+
 func assertShareLinks(shareLinks ShareLinks, uri, requestProtocol string) {
 	So(shareLinks, ShouldContainKey, model.SocialEmail.String())
 	So(shareLinks, ShouldContainKey, model.SocialLinkedin.String())
@@ -0,0 +1,235 @@
+package mapper
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"html/template"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ONSdigital/dp-renderer/helper"
+)
+
+// figureTag matches the legacy Zebedee figure tags embedded in section markdown, e.g. <ons-chart path="/a/b/c" />
+var figureTag = regexp.MustCompile(`<ons-(chart|table|image|equation)\b([^>]*?)/?>`)
+
+// figurePath matches the path attribute of a figure tag
+var figurePath = regexp.MustCompile(`\bpath\s*=\s*"([^"]*)"`)
+
+// htmlTag matches the start and end tags of the elements in rendered markdown
+var htmlTag = regexp.MustCompile(`<(/?)([a-zA-Z][a-zA-Z0-9]*)\b[^>]*>`)
+
+// voidElements have no end tag, so are never left open
+var voidElements = map[string]bool{"area": true, "br": true, "col": true, "hr": true, "img": true, "input": true, "wbr": true}
+
+// placeholderNonce returns a random string for the figure placeholders of a render, so that the text of the markdown
+// cannot be mistaken for a placeholder
+var placeholderNonce = func() string {
+	b := make([]byte, 8)
+	if _, err := rand.Read(b); err != nil {
+		return strconv.FormatInt(time.Now().UnixNano(), 16)
+	}
+	return hex.EncodeToString(b)
+}
+
+// ContentBlock is a fragment of rendered section content, holding either HTML or a figure
+type ContentBlock struct {
+	HTML   template.HTML `json:"html,omitempty"`
+	Figure *ViewFigure   `json:"figure,omitempty"`
+}
+
+// FigureReference is a figure tag found in section markdown that could not be resolved
+type FigureReference struct {
+	Section string `json:"section"`
+	Type    string `json:"type"`
+	Path    string `json:"path"`
+	Reason  string `json:"reason"`
+}
+
+// preprocessMarkdown renders the markdown of a section into content blocks, with a figure block in place of each figure
+// tag. The markdown is rendered in one piece, with a placeholder for each tag, so that a tag does not break up the
+// list or emphasis that it is in. A tag on its own line is a figure between paragraphs, and a tag within a paragraph
+// splits it in two. Tags that cannot be resolved are dropped and returned as references.
+func preprocessMarkdown(section, markdown string, model *BulletinModel) ([]ContentBlock, []FigureReference) {
+	figures := []*ViewFigure{}
+	unresolved := []FigureReference{}
+
+	// The placeholder that a figure tag is swapped for while the markdown is rendered is plain text, so markdown leaves
+	// it as it is
+	nonce := placeholderNonce()
+	for strings.Contains(markdown, nonce) {
+		nonce = placeholderNonce()
+	}
+
+	var md strings.Builder
+	start := 0
+	for _, loc := range figureTag.FindAllStringSubmatchIndex(markdown, -1) {
+		md.WriteString(markdown[start:loc[0]])
+		start = loc[1]
+
+		figureType := markdown[loc[2]:loc[3]]
+		ref := FigureReference{
+			Section: section,
+			Type:    figureType,
+		}
+
+		attrs := figurePath.FindStringSubmatch(markdown[loc[4]:loc[5]])
+		if attrs == nil || strings.TrimSpace(attrs[1]) == "" {
+			ref.Reason = "missing path"
+			unresolved = append(unresolved, ref)
+			continue
+		}
+		ref.Path = attrs[1]
+
+		figure, ok := findFigure(model, figureType, ref.Path)
+		if !ok {
+			ref.Reason = "unknown figure"
+			unresolved = append(unresolved, ref)
+			continue
+		}
+
+		placeholder := fmt.Sprintf("ONSFIGURE%s%dX", nonce, len(figures))
+		if isOnOwnLine(markdown, loc[0], loc[1]) {
+			placeholder = "\n\n" + placeholder + "\n\n"
+		}
+		md.WriteString(placeholder)
+		figures = append(figures, &ViewFigure{
+			Figure:   figure,
+			Language: model.Language,
+		})
+	}
+	md.WriteString(markdown[start:])
+
+	return splitRenderedMarkdown(string(helper.Markdown(md.String())), nonce, figures), unresolved
+}
+
+// isOnOwnLine reports whether the text from start to end is the only text on its line
+func isOnOwnLine(s string, start, end int) bool {
+	lineStart := strings.LastIndex(s[:start], "\n") + 1
+	lineEnd := strings.Index(s[end:], "\n")
+	if lineEnd < 0 {
+		lineEnd = len(s)
+	} else {
+		lineEnd += end
+	}
+	return strings.TrimSpace(s[lineStart:start]) == "" && strings.TrimSpace(s[end:lineEnd]) == ""
+}
+
+// splitRenderedMarkdown splits rendered markdown into content blocks at each figure placeholder. A figure cannot be
+// within a paragraph, so the paragraph that an inline placeholder is in is closed before the figure and reopened after
+// it, along with any elements open within the paragraph.
+func splitRenderedMarkdown(html, nonce string, figures []*ViewFigure) []ContentBlock {
+	placeholder := regexp.MustCompile(`<p>ONSFIGURE` + nonce + `(\d+)X</p>\n?|ONSFIGURE` + nonce + `(\d+)X`)
+
+	blocks := []ContentBlock{}
+	appendHTML := func(h string) {
+		if strings.TrimSpace(h) != "" {
+			blocks = append(blocks, ContentBlock{HTML: template.HTML(h)})
+		}
+	}
+
+	var open []openElement
+	start, scanned := 0, 0
+	reopen := ""
+	for _, loc := range placeholder.FindAllStringSubmatchIndex(html, -1) {
+		digits := loc[2:4]
+		if digits[0] < 0 {
+			digits = loc[4:6]
+		}
+		i, err := strconv.Atoi(html[digits[0]:digits[1]])
+		if err != nil || i >= len(figures) {
+			continue
+		}
+
+		open = openElements(open, html[scanned:loc[0]])
+		scanned = loc[1]
+
+		before := reopen + html[start:loc[0]]
+		start = loc[1]
+		reopen = ""
+
+		if p := lastParagraph(open); p >= 0 {
+			closing, opening := closeElements(open[p:]), openTags(open[p:])
+			if strings.HasSuffix(strings.TrimRight(before, " "), opening) {
+				// The figure starts the paragraph, so the paragraph is only opened after it
+				before = strings.TrimSuffix(strings.TrimRight(before, " "), opening)
+			} else {
+				before += closing
+			}
+
+			after := strings.TrimLeft(html[start:], " ")
+			if strings.HasPrefix(after, closing) {
+				// The figure ends the paragraph, so the paragraph is not reopened after it
+				start = len(html) - len(after) + len(closing)
+				open = openElements(open, html[scanned:start])
+				scanned = start
+			} else {
+				reopen = opening
+			}
+		}
+
+		appendHTML(before)
+		blocks = append(blocks, ContentBlock{Figure: figures[i]})
+	}
+	appendHTML(reopen + html[start:])
+
+	return blocks
+}
+
+// openElement is an element of rendered markdown that has been started but not ended
+type openElement struct {
+	name string
+	tag  string
+}
+
+// openElements returns the elements left open after html, given the elements that were open before it
+func openElements(open []openElement, html string) []openElement {
+	for _, m := range htmlTag.FindAllStringSubmatch(html, -1) {
+		name := strings.ToLower(m[2])
+		if voidElements[name] || strings.HasSuffix(m[0], "/>") {
+			continue
+		}
+		if m[1] == "" {
+			open = append(open, openElement{name: name, tag: m[0]})
+			continue
+		}
+		for i := len(open) - 1; i >= 0; i-- {
+			if open[i].name == name {
+				open = open[:i]
+				break
+			}
+		}
+	}
+	return open
+}
+
+// lastParagraph returns the index of the innermost open paragraph, or -1 if there is none
+func lastParagraph(open []openElement) int {
+	for i := len(open) - 1; i >= 0; i-- {
+		if open[i].name == "p" {
+			return i
+		}
+	}
+	return -1
+}
+
+// closeElements returns the end tags that close the open elements, innermost first
+func closeElements(open []openElement) string {
+	var b strings.Builder
+	for i := len(open) - 1; i >= 0; i-- {
+		b.WriteString("</" + open[i].name + ">")
+	}
+	return b.String()
+}
+
+// openTags returns the start tags that reopen the open elements, outermost first
+func openTags(open []openElement) string {
+	var b strings.Builder
+	for _, e := range open {
+		b.WriteString(e.tag)
+	}
+	return b.String()
+}
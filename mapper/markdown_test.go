@@ -0,0 +1,172 @@
+package mapper
+
+import (
+	"testing"
+
+	"github.com/ONSdigital/dp-api-clients-go/v2/articles"
+	"github.com/ONSdigital/dp-api-clients-go/v2/zebedee"
+	"github.com/ONSdigital/dp-frontend-articles-controller/mocks"
+	"github.com/ONSdigital/dp-renderer/helper"
+	coreModel "github.com/ONSdigital/dp-renderer/model"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestUnitPreprocessMarkdown(t *testing.T) {
+	helper.InitialiseLocalisationsHelper(mocks.MockAssetFunction)
+
+	Convey("Given a model with figures", t, func() {
+		model := &BulletinModel{
+			Charts: []Figure{
+				{Title: "chart 1", URI: "/a/bulletin/chart1", Type: FigureTypeChart},
+			},
+			Tables: []Figure{
+				{Title: "table 1", URI: "/a/bulletin/table1", Type: FigureTypeTable},
+			},
+		}
+		model.Language = "cy"
+
+		Convey("When the markdown has no figure tags", func() {
+			blocks, unresolved := preprocessMarkdown("section-0", "some markdown", model)
+
+			Convey("Then it is rendered as a single block", func() {
+				So(blocks, ShouldResemble, []ContentBlock{{HTML: "<p>some markdown</p>\n"}})
+				So(unresolved, ShouldBeEmpty)
+			})
+		})
+
+		Convey("When the markdown references known figures", func() {
+			md := "before\n<ons-chart path=\"/a/bulletin/chart1\" />\nbetween <ons-table path=\"a/bulletin/table1/\"/>after"
+			blocks, unresolved := preprocessMarkdown("section-0", md, model)
+
+			Convey("Then a tag on its own line is a figure between paragraphs", func() {
+				So(blocks, ShouldHaveLength, 5)
+				So(blocks[0].HTML, ShouldEqual, "<p>before</p>\n\n")
+				So(blocks[1].Figure.Title, ShouldEqual, "chart 1")
+				So(blocks[1].Figure.Language, ShouldEqual, "cy")
+				So(unresolved, ShouldBeEmpty)
+			})
+
+			Convey("Then a tag within a paragraph closes the paragraph before the figure and reopens it after", func() {
+				So(blocks[2].HTML, ShouldEqual, "\n<p>between </p>")
+				So(blocks[3].Figure.Title, ShouldEqual, "table 1")
+				So(blocks[4].HTML, ShouldEqual, "<p>after</p>\n")
+			})
+		})
+
+		Convey("When a figure is referenced within emphasised text", func() {
+			md := "some **bold <ons-chart path=\"/a/bulletin/chart1\" /> text** here"
+			blocks, _ := preprocessMarkdown("section-0", md, model)
+
+			Convey("Then the elements open within the paragraph are also closed and reopened", func() {
+				So(blocks, ShouldHaveLength, 3)
+				So(blocks[0].HTML, ShouldEqual, "<p>some <strong>bold </strong></p>")
+				So(blocks[1].Figure.Title, ShouldEqual, "chart 1")
+				So(blocks[2].HTML, ShouldEqual, "<p><strong> text</strong> here</p>\n")
+			})
+		})
+
+		Convey("When a figure starts or ends a paragraph", func() {
+			md := "<ons-chart path=\"/a/bulletin/chart1\" /> first\n\nsecond <ons-table path=\"/a/bulletin/table1\" />"
+			blocks, _ := preprocessMarkdown("section-0", md, model)
+
+			Convey("Then no empty paragraph is left", func() {
+				So(blocks, ShouldHaveLength, 3)
+				So(blocks[0].Figure.Title, ShouldEqual, "chart 1")
+				So(blocks[1].HTML, ShouldEqual, "<p> first</p>\n\n<p>second </p>")
+				So(blocks[2].Figure.Title, ShouldEqual, "table 1")
+			})
+		})
+
+		Convey("When a figure is referenced within a list", func() {
+			md := "* first <ons-chart path=\"/a/bulletin/chart1\" />\n* second\n* third"
+			blocks, _ := preprocessMarkdown("section-0", md, model)
+
+			Convey("Then the list is rendered in one piece", func() {
+				So(blocks, ShouldHaveLength, 3)
+				So(blocks[0].HTML, ShouldEqual, "<ul>\n<li>first ")
+				So(blocks[1].Figure.Title, ShouldEqual, "chart 1")
+				So(blocks[2].HTML, ShouldEqual, "</li>\n<li>second</li>\n<li>third</li>\n</ul>\n")
+			})
+		})
+
+		Convey("When the markdown has text that looks like a placeholder", func() {
+			blocks, _ := preprocessMarkdown("section-0", "ONSFIGURE0X", model)
+
+			Convey("Then it is left as it is", func() {
+				So(blocks, ShouldResemble, []ContentBlock{{HTML: "<p>ONSFIGURE0X</p>\n"}})
+			})
+		})
+
+		Convey("When the markdown has the placeholder of the render in it", func() {
+			defer func(nonce func() string) { placeholderNonce = nonce }(placeholderNonce)
+			nonces := []string{"abc", "def"}
+			placeholderNonce = func() string {
+				nonce := nonces[0]
+				nonces = nonces[1:]
+				return nonce
+			}
+
+			md := "ONSFIGUREabc0X\n<ons-chart path=\"/a/bulletin/chart1\" />"
+			blocks, _ := preprocessMarkdown("section-0", md, model)
+
+			Convey("Then a different placeholder is used, and the text is left as it is", func() {
+				So(blocks, ShouldHaveLength, 2)
+				So(blocks[0].HTML, ShouldEqual, "<p>ONSFIGUREabc0X</p>\n\n")
+				So(blocks[1].Figure.Title, ShouldEqual, "chart 1")
+			})
+		})
+
+		Convey("When the markdown references unknown or broken figures", func() {
+			md := "<ons-image path=\"/a/bulletin/missing\" /><ons-chart /><ons-table path=\"\"></ons-table>"
+			blocks, unresolved := preprocessMarkdown("section-1", md, model)
+
+			Convey("Then the tags are dropped and reported", func() {
+				So(blocks, ShouldResemble, []ContentBlock{{HTML: "<p></ons-table></p>\n"}})
+				So(unresolved, ShouldResemble, []FigureReference{
+					{Section: "section-1", Type: FigureTypeImage, Path: "/a/bulletin/missing", Reason: "unknown figure"},
+					{Section: "section-1", Type: FigureTypeChart, Reason: "missing path"},
+					{Section: "section-1", Type: FigureTypeTable, Reason: "missing path"},
+				})
+			})
+		})
+	})
+
+	Convey("Given a bulletin with figures referenced in its sections", t, func() {
+		bulletin := articles.Bulletin{
+			Type:        "bulletin",
+			URI:         "/a/bulletin",
+			Description: zebedee.Description{Title: "A bulletin"},
+			Sections: []zebedee.Section{
+				{
+					Title:    "section1",
+					Markdown: "text\n<ons-chart path=\"/a/bulletin/chart1\" />",
+				},
+				{
+					Title:    "section2",
+					Markdown: "<ons-image path=\"/a/bulletin/missing\" />",
+				},
+			},
+			Charts: []zebedee.Figure{
+				{Title: "chart 1", URI: "/a/bulletin/chart1"},
+			},
+		}
+
+		Convey("When CreateBulletinModel is called", func() {
+			model, err := CreateBulletinModel(coreModel.NewPage("path/to/assets", "site-domain"), bulletin, []zebedee.Breadcrumb{}, "en", "https", "", zebedee.EmergencyBanner{}, "")
+			So(err, ShouldBeNil)
+
+			Convey("Then the section content is split into blocks", func() {
+				So(model.ContentsView[0].Blocks, ShouldHaveLength, 2)
+				So(model.ContentsView[0].Blocks[1].Figure.Type, ShouldEqual, FigureTypeChart)
+				So(model.ContentsView[1].Blocks, ShouldBeEmpty)
+			})
+
+			Convey("Then the unresolved figures are recorded on the model", func() {
+				So(model.UnresolvedFigures, ShouldResemble, []FigureReference{
+					{Section: "section-1", Type: FigureTypeImage, Path: "/a/bulletin/missing", Reason: "unknown figure"},
+				})
+			})
+		})
+	})
+}
@@ -0,0 +1,69 @@
+package mapper
+
+import (
+	"net/url"
+
+	"github.com/ONSdigital/dp-renderer/helper"
+)
+
+// SocialMetadata is the Open Graph and Twitter card metadata of a page, for the preview card shown when a link to the
+// page is shared
+type SocialMetadata struct {
+	Title       string `json:"title"`
+	Description string `json:"description"`
+	URL         string `json:"url"`
+	Locale      string `json:"locale"`
+	SiteName    string `json:"siteName"`
+	ImageURL    string `json:"imageUrl"`
+	ImageAlt    string `json:"imageAlt"`
+	TwitterCard string `json:"twitterCard"`
+}
+
+// createSocialMetadata creates the social metadata of a bulletin or article page at currentUrl. The image of the
+// preview is the first image of the page, or its first chart if it has no images, or defaultImageURL otherwise.
+func createSocialMetadata(model BulletinModel, requestProtocol, currentUrl, defaultImageURL string) SocialMetadata {
+	description := model.Summary
+	if description == "" {
+		description = model.Metadata.Description
+	}
+
+	metadata := SocialMetadata{
+		Title:       model.Metadata.Title,
+		Description: description,
+		URL:         currentUrl,
+		Locale:      "en_GB",
+		SiteName:    helper.Localise("SocialSiteName", model.Language, 1),
+		ImageURL:    defaultImageURL,
+		TwitterCard: "summary",
+	}
+	if model.Language == "cy" {
+		metadata.Locale = "cy_GB"
+	}
+
+	for _, figures := range [][]Figure{model.Images, model.Charts} {
+		if len(figures) > 0 {
+			metadata.ImageURL = getAbsoluteUrl(requestProtocol, model.SiteDomain, figures[0].ImageURI, model.Language)
+			metadata.ImageAlt = figures[0].Title
+			break
+		}
+	}
+	if metadata.ImageURL != "" {
+		metadata.TwitterCard = "summary_large_image"
+	}
+
+	return metadata
+}
+
+// getAbsoluteUrl resolves a reference on the site, such as the image of a figure, to an absolute URL that can be
+// fetched by other sites
+func getAbsoluteUrl(requestProtocol, siteDomain, ref, lang string) string {
+	base, err := url.Parse(getCurrentUrl(requestProtocol, siteDomain, "/", lang))
+	if err != nil {
+		return ""
+	}
+	r, err := url.Parse(ref)
+	if err != nil {
+		return ""
+	}
+	return base.ResolveReference(r).String()
+}
@@ -0,0 +1,106 @@
+package mapper
+
+import (
+	"testing"
+
+	"github.com/ONSdigital/dp-api-clients-go/v2/articles"
+	"github.com/ONSdigital/dp-api-clients-go/v2/zebedee"
+	"github.com/ONSdigital/dp-frontend-articles-controller/mocks"
+	"github.com/ONSdigital/dp-renderer/helper"
+	coreModel "github.com/ONSdigital/dp-renderer/model"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestUnitSocialMetadata(t *testing.T) {
+	helper.InitialiseLocalisationsHelper(mocks.MockAssetFunction)
+
+	Convey("Given a bulletin without images or charts", t, func() {
+		bulletin := articles.Bulletin{
+			URI:  "/economy/grossdomesticproductgdp/bulletins/gdpmonthlyestimateuk/june2022",
+			Type: "bulletin",
+			Description: zebedee.Description{
+				Title:           "GDP monthly estimate, UK",
+				Summary:         "Monthly gross domestic product (GDP) estimates.",
+				MetaDescription: "Monthly GDP estimates for the UK.",
+			},
+		}
+		basePage := coreModel.NewPage("path/to/assets", "ons.gov.uk")
+		pageURL := "https://ons.gov.uk/economy/grossdomesticproductgdp/bulletins/gdpmonthlyestimateuk/june2022"
+
+		Convey("When it is mapped without a default image", func() {
+			model, err := CreateBulletinModel(basePage, bulletin, nil, "en", "https", "", zebedee.EmergencyBanner{}, "")
+			So(err, ShouldBeNil)
+
+			Convey("Then its preview card has a summary without an image", func() {
+				So(model.SocialMetadata, ShouldResemble, SocialMetadata{
+					Title:       "GDP monthly estimate, UK",
+					Description: "Monthly gross domestic product (GDP) estimates.",
+					URL:         pageURL,
+					Locale:      "en_GB",
+					SiteName:    "Office for National Statistics",
+					TwitterCard: "summary",
+				})
+			})
+		})
+
+		Convey("When it is mapped with a default image", func() {
+			model, err := CreateBulletinModel(basePage, bulletin, nil, "en", "https", "", zebedee.EmergencyBanner{}, "https://cdn.example.com/ons.png")
+			So(err, ShouldBeNil)
+
+			Convey("Then its preview card has the default image", func() {
+				So(model.SocialMetadata.ImageURL, ShouldEqual, "https://cdn.example.com/ons.png")
+				So(model.SocialMetadata.ImageAlt, ShouldBeEmpty)
+				So(model.SocialMetadata.TwitterCard, ShouldEqual, "summary_large_image")
+			})
+		})
+
+		Convey("When it is mapped in Welsh", func() {
+			model, err := CreateBulletinModel(basePage, bulletin, nil, "cy", "https", "", zebedee.EmergencyBanner{}, "")
+			So(err, ShouldBeNil)
+
+			Convey("Then its preview card is for the Welsh site", func() {
+				So(model.SocialMetadata.URL, ShouldEqual, "https://cy.ons.gov.uk/economy/grossdomesticproductgdp/bulletins/gdpmonthlyestimateuk/june2022")
+				So(model.SocialMetadata.Locale, ShouldEqual, "cy_GB")
+				So(model.SocialMetadata.SiteName, ShouldEqual, "Swyddfa Ystadegau Gwladol")
+			})
+		})
+
+		Convey("When it has no summary", func() {
+			bulletin.Description.Summary = ""
+			model, err := CreateBulletinModel(basePage, bulletin, nil, "en", "https", "", zebedee.EmergencyBanner{}, "")
+			So(err, ShouldBeNil)
+
+			Convey("Then its preview card has the meta description", func() {
+				So(model.SocialMetadata.Description, ShouldEqual, "Monthly GDP estimates for the UK.")
+			})
+		})
+
+		Convey("When it has charts", func() {
+			bulletin.Charts = []zebedee.Figure{
+				{Title: "Figure 1: Monthly GDP", URI: "/economy/grossdomesticproductgdp/bulletins/gdpmonthlyestimateuk/june2022/1a2b3c4d"},
+				{Title: "Figure 2: Services", URI: "/economy/grossdomesticproductgdp/bulletins/gdpmonthlyestimateuk/june2022/5e6f7a8b"},
+			}
+			model, err := CreateBulletinModel(basePage, bulletin, nil, "en", "https", "", zebedee.EmergencyBanner{}, "https://cdn.example.com/ons.png")
+			So(err, ShouldBeNil)
+
+			Convey("Then its preview card has the image of the first chart", func() {
+				So(model.SocialMetadata.ImageURL, ShouldEqual, "https://ons.gov.uk/chartimage?uri=/economy/grossdomesticproductgdp/bulletins/gdpmonthlyestimateuk/june2022/1a2b3c4d")
+				So(model.SocialMetadata.ImageAlt, ShouldEqual, "Figure 1: Monthly GDP")
+				So(model.SocialMetadata.TwitterCard, ShouldEqual, "summary_large_image")
+			})
+
+			Convey("And images", func() {
+				bulletin.Images = []zebedee.Figure{
+					{Title: "Figure 3: A map", URI: "/economy/grossdomesticproductgdp/bulletins/gdpmonthlyestimateuk/june2022/9c0d1e2f"},
+				}
+				model, err := CreateBulletinModel(basePage, bulletin, nil, "en", "https", "", zebedee.EmergencyBanner{}, "")
+				So(err, ShouldBeNil)
+
+				Convey("Then its preview card has the first image", func() {
+					So(model.SocialMetadata.ImageURL, ShouldEqual, "https://ons.gov.uk/resource?uri=/economy/grossdomesticproductgdp/bulletins/gdpmonthlyestimateuk/june2022/9c0d1e2f.png")
+					So(model.SocialMetadata.ImageAlt, ShouldEqual, "Figure 3: A map")
+				})
+			})
+		})
+	})
+}
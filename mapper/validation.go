@@ -0,0 +1,79 @@
+package mapper
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/ONSdigital/dp-api-clients-go/v2/articles"
+	"github.com/ONSdigital/dp-frontend-articles-controller/upstream"
+)
+
+// ValidationError is returned when content from an upstream service is too malformed to be mapped to a page. Service
+// is the upstream service that the content came from.
+type ValidationError struct {
+	URI      string
+	Service  string
+	Problems []string
+}
+
+func (e *ValidationError) Error() string {
+	return fmt.Sprintf("invalid content at uri %q: %s", e.URI, strings.Join(e.Problems, "; "))
+}
+
+// validateBulletin checks the fields of a bulletin that a page cannot be mapped without. The release date is only
+// checked when it is set, as it is not known for some content.
+func validateBulletin(bulletin articles.Bulletin) error {
+	var problems []string
+
+	if !isValidURI(bulletin.URI) {
+		problems = append(problems, "uri must be an absolute path")
+	}
+	if strings.TrimSpace(bulletin.Description.Title) == "" {
+		problems = append(problems, "title must not be empty")
+	}
+	if !isValidDate(bulletin.Description.ReleaseDate) {
+		problems = append(problems, "release date must be in RFC 3339 format")
+	}
+
+	if len(problems) > 0 {
+		return &ValidationError{URI: bulletin.URI, Service: upstream.ArticlesAPI, Problems: problems}
+	}
+	return nil
+}
+
+// validateCompendium checks the fields of the compendium landing page from Zebedee that the navigation between its
+// chapters cannot be mapped without
+func validateCompendium(compendium CompendiumLandingPage) error {
+	var problems []string
+
+	if !isValidURI(compendium.URI) {
+		problems = append(problems, "uri must be an absolute path")
+	}
+	for i, c := range compendium.Chapters {
+		if !isValidURI(c.URI) {
+			problems = append(problems, fmt.Sprintf("uri of chapter %d must be an absolute path", i+1))
+		}
+	}
+
+	if len(problems) > 0 {
+		return &ValidationError{URI: compendium.URI, Service: upstream.Zebedee, Problems: problems}
+	}
+	return nil
+}
+
+// isValidURI reports whether uri is an absolute path, without any characters that cannot appear in a path
+func isValidURI(uri string) bool {
+	if !strings.HasPrefix(uri, "/") {
+		return false
+	}
+	return !strings.ContainsAny(uri, " \t\r\n?#")
+}
+
+func isValidDate(date string) bool {
+	if date == "" {
+		return true
+	}
+	_, err := time.Parse(time.RFC3339, date)
+	return err == nil
+}
@@ -0,0 +1,214 @@
+package mapper
+
+import (
+	"errors"
+	"math/rand"
+	"reflect"
+	"strings"
+	"testing"
+	"testing/quick"
+
+	"github.com/ONSdigital/dp-api-clients-go/v2/articles"
+	"github.com/ONSdigital/dp-api-clients-go/v2/zebedee"
+	"github.com/ONSdigital/dp-frontend-articles-controller/mocks"
+	"github.com/ONSdigital/dp-frontend-articles-controller/upstream"
+	"github.com/ONSdigital/dp-renderer/helper"
+	coreModel "github.com/ONSdigital/dp-renderer/model"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestUnitValidation(t *testing.T) {
+	valid := func() articles.Bulletin {
+		return articles.Bulletin{
+			URI: "/economy/bulletins/gdp/2022",
+			Description: zebedee.Description{
+				Title:       "GDP",
+				ReleaseDate: "2022-08-12T06:00:00.000Z",
+			},
+		}
+	}
+
+	Convey("validateBulletin", t, func() {
+		Convey("accepts a well formed bulletin", func() {
+			So(validateBulletin(valid()), ShouldBeNil)
+		})
+
+		Convey("accepts a bulletin without a release date", func() {
+			b := valid()
+			b.Description.ReleaseDate = ""
+			So(validateBulletin(b), ShouldBeNil)
+		})
+
+		testCases := []struct {
+			name    string
+			modify  func(b *articles.Bulletin)
+			problem string
+		}{
+			{"an empty uri", func(b *articles.Bulletin) { b.URI = "" }, "uri must be an absolute path"},
+			{"a relative uri", func(b *articles.Bulletin) { b.URI = "economy/bulletins" }, "uri must be an absolute path"},
+			{"a uri with a query", func(b *articles.Bulletin) { b.URI = "/economy?lang=cy" }, "uri must be an absolute path"},
+			{"a uri with whitespace", func(b *articles.Bulletin) { b.URI = "/economy/gdp 2022" }, "uri must be an absolute path"},
+			{"an empty title", func(b *articles.Bulletin) { b.Description.Title = "" }, "title must not be empty"},
+			{"a blank title", func(b *articles.Bulletin) { b.Description.Title = " \n" }, "title must not be empty"},
+			{"a release date that is not RFC 3339", func(b *articles.Bulletin) { b.Description.ReleaseDate = "12 August 2022" }, "release date must be in RFC 3339 format"},
+		}
+
+		for _, tc := range testCases {
+			Convey("rejects "+tc.name, func() {
+				b := valid()
+				tc.modify(&b)
+
+				err := validateBulletin(b)
+
+				var validationErr *ValidationError
+				So(errors.As(err, &validationErr), ShouldBeTrue)
+				So(validationErr.URI, ShouldEqual, b.URI)
+				So(validationErr.Service, ShouldEqual, upstream.ArticlesAPI)
+				So(validationErr.Problems, ShouldResemble, []string{tc.problem})
+			})
+		}
+
+		Convey("reports every problem", func() {
+			err := validateBulletin(articles.Bulletin{Description: zebedee.Description{ReleaseDate: "soon"}})
+			So(err.Error(), ShouldEqual, `invalid content at uri "": uri must be an absolute path; title must not be empty; release date must be in RFC 3339 format`)
+		})
+	})
+
+	Convey("validateCompendium", t, func() {
+		compendium := CompendiumLandingPage{
+			URI:      "/economy/compendium/2022",
+			Chapters: []zebedee.Link{{URI: "/economy/compendium/2022/chapter1"}, {URI: "/economy/compendium/2022/chapter2"}},
+		}
+
+		Convey("accepts a well formed compendium", func() {
+			So(validateCompendium(compendium), ShouldBeNil)
+		})
+
+		Convey("rejects malformed uris, attributing them to Zebedee", func() {
+			compendium.Chapters[1].URI = "chapter2"
+
+			err := validateCompendium(compendium)
+
+			var validationErr *ValidationError
+			So(errors.As(err, &validationErr), ShouldBeTrue)
+			So(validationErr.Service, ShouldEqual, upstream.Zebedee)
+			So(validationErr.Problems, ShouldResemble, []string{"uri of chapter 2 must be an absolute path"})
+		})
+	})
+
+	Convey("parentPath", t, func() {
+		So(parentPath("/economy/bulletins/gdp"), ShouldEqual, "/economy/bulletins")
+		So(parentPath("/economy"), ShouldEqual, "")
+		So(parentPath("economy"), ShouldEqual, "")
+		So(parentPath(""), ShouldEqual, "")
+	})
+}
+
+// randomBulletin generates bulletins from fragments of well formed and malformed content, so that the mappers are
+// exercised with the content that upstream services could plausibly send
+type randomBulletin struct {
+	articles.Bulletin
+}
+
+var (
+	uriSegments = []string{"", "/", "//", "economy", "bulletins", "previous", "v1", "a b", "?", "#", "é"}
+	titles      = []string{"", " ", "GDP", "Welsh ŵ", "<script>"}
+	dates       = []string{"", "2022-08-12T06:00:00.000Z", "2022-08-12T06:00:00Z", "12 August 2022", "2022-13-45T99:00:00Z", "0001-01-01T00:00:00Z"}
+	markdown    = []string{"", "text", "<ons-chart path=\"\" />", "<ons-image path=\"/economy/image\" />", "<ons-table", "<ons-chart path=\"/a/b\" /><ons-chart path=\"/a/b\" />"}
+)
+
+func pick(r *rand.Rand, values []string) string {
+	return values[r.Intn(len(values))]
+}
+
+func randomURI(r *rand.Rand) string {
+	segments := make([]string, r.Intn(5))
+	for i := range segments {
+		segments[i] = pick(r, uriSegments)
+	}
+	uri := strings.Join(segments, "/")
+	if r.Intn(4) > 0 {
+		uri = "/" + uri
+	}
+	return uri
+}
+
+// Generate implements quick.Generator
+func (randomBulletin) Generate(r *rand.Rand, size int) reflect.Value {
+	b := articles.Bulletin{
+		URI:              randomURI(r),
+		Type:             "bulletin",
+		LatestReleaseURI: randomURI(r),
+		Description: zebedee.Description{
+			Title:       pick(r, titles),
+			ReleaseDate: pick(r, dates),
+			NextRelease: pick(r, dates),
+		},
+	}
+	for i := r.Intn(size + 1); i > 0; i-- {
+		b.Sections = append(b.Sections, zebedee.Section{Title: pick(r, titles), Markdown: pick(r, markdown)})
+	}
+	for i := r.Intn(3); i > 0; i-- {
+		b.Accordion = append(b.Accordion, zebedee.Section{Title: pick(r, titles), Markdown: pick(r, markdown)})
+	}
+	for i := r.Intn(3); i > 0; i-- {
+		b.Charts = append(b.Charts, zebedee.Figure{Title: pick(r, titles), URI: randomURI(r)})
+		b.Images = append(b.Images, zebedee.Figure{Title: pick(r, titles), URI: randomURI(r)})
+	}
+	for i := r.Intn(3); i > 0; i-- {
+		b.Versions = append(b.Versions, zebedee.Version{URI: randomURI(r), ReleaseDate: pick(r, dates)})
+		b.Alerts = append(b.Alerts, zebedee.Alert{Date: pick(r, dates), Markdown: pick(r, markdown)})
+	}
+	for i := r.Intn(3); i > 0; i-- {
+		b.RelatedBulletins = append(b.RelatedBulletins, zebedee.Link{Title: pick(r, titles), URI: randomURI(r)})
+	}
+	return reflect.ValueOf(randomBulletin{b})
+}
+
+func TestPropertyMappers(t *testing.T) {
+	helper.InitialiseLocalisationsHelper(mocks.MockAssetFunction)
+	cfg := &quick.Config{MaxCount: 2000}
+
+	// isMappedOrInvalid reports whether a mapper either mapped the bulletin, or rejected it with a validation error
+	isMappedOrInvalid := func(b articles.Bulletin, uri string, err error) bool {
+		if err != nil {
+			var validationErr *ValidationError
+			return errors.As(err, &validationErr) && validateBulletin(b) != nil
+		}
+		return uri == b.URI && validateBulletin(b) == nil
+	}
+
+	t.Run("CreateBulletinModel", func(t *testing.T) {
+		property := func(rb randomBulletin) bool {
+			for _, lang := range []string{"en", "cy"} {
+				model, err := CreateBulletinModel(coreModel.Page{}, rb.Bulletin, nil, lang, "https", "", zebedee.EmergencyBanner{}, "")
+				if !isMappedOrInvalid(rb.Bulletin, model.URI, err) {
+					return false
+				}
+			}
+			return true
+		}
+		if err := quick.Check(property, cfg); err != nil {
+			t.Error(err)
+		}
+	})
+
+	t.Run("CreateSixteensBulletinModel", func(t *testing.T) {
+		property := func(rb randomBulletin) bool {
+			model, err := CreateSixteensBulletinModel(coreModel.Page{}, rb.Bulletin, nil, "en")
+			return isMappedOrInvalid(rb.Bulletin, model.URI, err)
+		}
+		if err := quick.Check(property, cfg); err != nil {
+			t.Error(err)
+		}
+	})
+
+	t.Run("parentPath", func(t *testing.T) {
+		property := func(p string) bool {
+			return strings.HasPrefix(p, parentPath(p))
+		}
+		if err := quick.Check(property, cfg); err != nil {
+			t.Error(err)
+		}
+	})
+}
@@ -0,0 +1,124 @@
+package metrics
+
+import (
+	"context"
+	"io"
+	"time"
+
+	"github.com/ONSdigital/dp-api-clients-go/v2/articles"
+	"github.com/ONSdigital/dp-api-clients-go/v2/zebedee"
+	"github.com/ONSdigital/dp-frontend-articles-controller/handlers"
+	"github.com/ONSdigital/dp-frontend-articles-controller/pdf"
+	"github.com/ONSdigital/dp-frontend-articles-controller/upstream"
+)
+
+// ArticlesAPIClient records the latency and errors of calls to the Articles API
+type ArticlesAPIClient struct {
+	handlers.ArticlesApiClient
+	metrics *Metrics
+}
+
+// NewArticlesAPIClient wraps an Articles API client with metrics
+func (m *Metrics) NewArticlesAPIClient(ac handlers.ArticlesApiClient) *ArticlesAPIClient {
+	return &ArticlesAPIClient{
+		ArticlesApiClient: ac,
+		metrics:           m,
+	}
+}
+
+// GetLegacyBulletin returns a legacy bulletin
+func (c *ArticlesAPIClient) GetLegacyBulletin(ctx context.Context, userAccessToken, collectionID, lang, uri string) (*articles.Bulletin, error) {
+	start := time.Now()
+	bulletin, err := c.ArticlesApiClient.GetLegacyBulletin(ctx, userAccessToken, collectionID, lang, uri)
+	c.metrics.observeUpstream(upstream.ArticlesAPI, "get_legacy_bulletin", start, err)
+	return bulletin, err
+}
+
+// ZebedeeClient records the latency and errors of calls to Zebedee
+type ZebedeeClient struct {
+	handlers.ZebedeeClient
+	metrics *Metrics
+}
+
+// NewZebedeeClient wraps a Zebedee client with metrics
+func (m *Metrics) NewZebedeeClient(zc handlers.ZebedeeClient) *ZebedeeClient {
+	return &ZebedeeClient{
+		ZebedeeClient: zc,
+		metrics:       m,
+	}
+}
+
+// GetBreadcrumb returns the breadcrumb of a page
+func (c *ZebedeeClient) GetBreadcrumb(ctx context.Context, userAccessToken, collectionID, lang, uri string) ([]zebedee.Breadcrumb, error) {
+	start := time.Now()
+	breadcrumbs, err := c.ZebedeeClient.GetBreadcrumb(ctx, userAccessToken, collectionID, lang, uri)
+	c.metrics.observeUpstream(upstream.Zebedee, "get_breadcrumb", start, err)
+	return breadcrumbs, err
+}
+
+// GetHomepageContent returns the content of the homepage
+func (c *ZebedeeClient) GetHomepageContent(ctx context.Context, userAccessToken, collectionID, lang, path string) (zebedee.HomepageContent, error) {
+	start := time.Now()
+	content, err := c.ZebedeeClient.GetHomepageContent(ctx, userAccessToken, collectionID, lang, path)
+	c.metrics.observeUpstream(upstream.Zebedee, "get_homepage_content", start, err)
+	return content, err
+}
+
+// GetPageTitle returns the title of a page
+func (c *ZebedeeClient) GetPageTitle(ctx context.Context, userAccessToken, collectionID, lang, uri string) (zebedee.PageTitle, error) {
+	start := time.Now()
+	title, err := c.ZebedeeClient.GetPageTitle(ctx, userAccessToken, collectionID, lang, uri)
+	c.metrics.observeUpstream(upstream.Zebedee, "get_page_title", start, err)
+	return title, err
+}
+
+// Get returns the response body of a request to Zebedee
+func (c *ZebedeeClient) Get(ctx context.Context, userAccessToken, path string) ([]byte, error) {
+	start := time.Now()
+	b, err := c.ZebedeeClient.Get(ctx, userAccessToken, path)
+	c.metrics.observeUpstream(upstream.Zebedee, "get", start, err)
+	return b, err
+}
+
+// PDFClient records the latency and errors of calls to the PDF service
+type PDFClient struct {
+	handlers.PDFClient
+	metrics *Metrics
+}
+
+// NewPDFClient wraps a PDF client with metrics
+func (m *Metrics) NewPDFClient(pc handlers.PDFClient) *PDFClient {
+	return &PDFClient{
+		PDFClient: pc,
+		metrics:   m,
+	}
+}
+
+// GetPDF returns the PDF version of a page. Only the time taken to start streaming the PDF is recorded.
+func (c *PDFClient) GetPDF(ctx context.Context, userAccessToken, collectionID, lang, uri string) (*pdf.PDF, error) {
+	start := time.Now()
+	p, err := c.PDFClient.GetPDF(ctx, userAccessToken, collectionID, lang, uri)
+	c.metrics.observeUpstream(upstream.PDF, "get_pdf", start, err)
+	return p, err
+}
+
+// RenderClient records how long pages take to render
+type RenderClient struct {
+	handlers.RenderClient
+	metrics *Metrics
+}
+
+// NewRenderClient wraps a render client with metrics
+func (m *Metrics) NewRenderClient(rc handlers.RenderClient) *RenderClient {
+	return &RenderClient{
+		RenderClient: rc,
+		metrics:      m,
+	}
+}
+
+// BuildPage renders a page from its model
+func (c *RenderClient) BuildPage(w io.Writer, pageModel interface{}, templateName string) {
+	start := time.Now()
+	c.RenderClient.BuildPage(w, pageModel, templateName)
+	c.metrics.renderDuration.WithLabelValues(templateName).Observe(time.Since(start).Seconds())
+}
@@ -0,0 +1,68 @@
+package metrics
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/ONSdigital/dp-api-clients-go/v2/articles"
+	"github.com/ONSdigital/dp-frontend-articles-controller/handlers"
+	gomock "github.com/golang/mock/gomock"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestUnitClients(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	ctx := context.Background()
+	anyCtx := gomock.Any()
+
+	Convey("Given clients wrapped with metrics", t, func() {
+		m := New(prometheus.NewRegistry())
+		mockArticlesApiClient := handlers.NewMockArticlesApiClient(mockCtrl)
+		mockZebedeeClient := handlers.NewMockZebedeeClient(mockCtrl)
+		mockPDFClient := handlers.NewMockPDFClient(mockCtrl)
+		mockRenderClient := handlers.NewMockRenderClient(mockCtrl)
+		ac := m.NewArticlesAPIClient(mockArticlesApiClient)
+		zc := m.NewZebedeeClient(mockZebedeeClient)
+		pc := m.NewPDFClient(mockPDFClient)
+		rc := m.NewRenderClient(mockRenderClient)
+
+		Convey("When upstream calls succeed", func() {
+			mockArticlesApiClient.EXPECT().GetLegacyBulletin(anyCtx, "", "", "en", "/a/bulletin").Return(&articles.Bulletin{}, nil)
+			mockZebedeeClient.EXPECT().GetBreadcrumb(anyCtx, "", "", "en", "/a/bulletin")
+			ac.GetLegacyBulletin(ctx, "", "", "en", "/a/bulletin")
+			zc.GetBreadcrumb(ctx, "", "", "en", "/a/bulletin")
+
+			Convey("Then their latency is observed without errors", func() {
+				So(testutil.CollectAndCount(m.upstreamDuration), ShouldEqual, 2)
+				So(testutil.CollectAndCount(m.upstreamErrors), ShouldEqual, 0)
+			})
+		})
+
+		Convey("When upstream calls fail", func() {
+			mockZebedeeClient.EXPECT().Get(anyCtx, "", "/data?uri=/a").Return(nil, errors.New("zebedee is down"))
+			mockPDFClient.EXPECT().GetPDF(anyCtx, "", "", "en", "/a/bulletin").Return(nil, errors.New("pdf service is down"))
+			zc.Get(ctx, "", "/data?uri=/a")
+			pc.GetPDF(ctx, "", "", "en", "/a/bulletin")
+
+			Convey("Then the errors are counted by service and call", func() {
+				So(testutil.ToFloat64(m.upstreamErrors.WithLabelValues("zebedee", "get")), ShouldEqual, 1)
+				So(testutil.ToFloat64(m.upstreamErrors.WithLabelValues("pdf-service", "get_pdf")), ShouldEqual, 1)
+			})
+		})
+
+		Convey("When a page is rendered", func() {
+			var buf bytes.Buffer
+			mockRenderClient.EXPECT().BuildPage(&buf, nil, "bulletin")
+			rc.BuildPage(&buf, nil, "bulletin")
+
+			Convey("Then the render duration is observed for its template", func() {
+				So(testutil.CollectAndCount(m.renderDuration), ShouldEqual, 1)
+			})
+		})
+	})
+}
@@ -0,0 +1,143 @@
+package metrics
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/ONSdigital/dp-frontend-articles-controller/cache"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const namespace = "articles_controller"
+
+// Metrics holds the Prometheus metrics of the controller, registered with a registry that is served at /metrics
+type Metrics struct {
+	registry *prometheus.Registry
+
+	requests         *prometheus.CounterVec
+	requestDuration  *prometheus.HistogramVec
+	upstreamDuration *prometheus.HistogramVec
+	upstreamErrors   *prometheus.CounterVec
+	renderDuration   *prometheus.HistogramVec
+	panics           prometheus.Counter
+}
+
+// New creates the metrics of the controller and registers them with the registry
+func New(registry *prometheus.Registry) *Metrics {
+	m := &Metrics{
+		registry: registry,
+		requests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "http_requests_total",
+			Help:      "The number of requests handled, by route and response status.",
+		}, []string{"route", "code"}),
+		requestDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Name:      "http_request_duration_seconds",
+			Help:      "The time taken to handle requests, by route.",
+			Buckets:   prometheus.DefBuckets,
+		}, []string{"route"}),
+		upstreamDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Name:      "upstream_request_duration_seconds",
+			Help:      "The time taken by calls to upstream services, by service and call.",
+			Buckets:   prometheus.DefBuckets,
+		}, []string{"service", "call"}),
+		upstreamErrors: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "upstream_errors_total",
+			Help:      "The number of calls to upstream services that failed, by service and call.",
+		}, []string{"service", "call"}),
+		renderDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Name:      "render_duration_seconds",
+			Help:      "The time taken to render pages, by template.",
+			Buckets:   prometheus.DefBuckets,
+		}, []string{"template"}),
+		panics: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "panics_total",
+			Help:      "The number of panics recovered from while handling requests.",
+		}),
+	}
+
+	registry.MustRegister(m.requests, m.requestDuration, m.upstreamDuration, m.upstreamErrors, m.renderDuration, m.panics)
+	return m
+}
+
+// Handler serves the metrics in the Prometheus exposition format
+func (m *Metrics) Handler() http.Handler {
+	return promhttp.HandlerFor(m.registry, promhttp.HandlerOpts{})
+}
+
+// RegisterCache adds the statistics of the cache to the metrics
+func (m *Metrics) RegisterCache(c *cache.Cache) {
+	m.registry.MustRegister(
+		prometheus.NewCounterFunc(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "cache_hits_total",
+			Help:      "The number of upstream responses served from the cache.",
+		}, func() float64 { return float64(c.Stats().Hits) }),
+		prometheus.NewCounterFunc(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "cache_misses_total",
+			Help:      "The number of upstream responses that were not in the cache.",
+		}, func() float64 { return float64(c.Stats().Misses) }),
+		prometheus.NewGaugeFunc(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Name:      "cache_entries",
+			Help:      "The number of upstream responses in the cache.",
+		}, func() float64 { return float64(c.Stats().Size) }),
+	)
+}
+
+// RecordPanic counts a panic recovered from while handling a request
+func (m *Metrics) RecordPanic() {
+	m.panics.Inc()
+}
+
+// statusRecorder records the status written to a response
+type statusRecorder struct {
+	http.ResponseWriter
+	status int
+}
+
+func (s *statusRecorder) WriteHeader(status int) {
+	if s.status == 0 {
+		s.status = status
+	}
+	s.ResponseWriter.WriteHeader(status)
+}
+
+func (s *statusRecorder) Write(b []byte) (int, error) {
+	if s.status == 0 {
+		s.status = http.StatusOK
+	}
+	return s.ResponseWriter.Write(b)
+}
+
+// Route counts the requests handled by a route, and how long they take
+func (m *Metrics) Route(route string, h http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, req *http.Request) {
+		start := time.Now()
+		rec := &statusRecorder{ResponseWriter: w}
+
+		h(rec, req)
+
+		if rec.status == 0 {
+			rec.status = http.StatusOK
+		}
+		m.requests.WithLabelValues(route, strconv.Itoa(rec.status)).Inc()
+		m.requestDuration.WithLabelValues(route).Observe(time.Since(start).Seconds())
+	}
+}
+
+// observeUpstream records the duration of a call to an upstream service, and whether it failed
+func (m *Metrics) observeUpstream(service, call string, start time.Time, err error) {
+	m.upstreamDuration.WithLabelValues(service, call).Observe(time.Since(start).Seconds())
+	if err != nil {
+		m.upstreamErrors.WithLabelValues(service, call).Inc()
+	}
+}
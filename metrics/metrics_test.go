@@ -0,0 +1,78 @@
+package metrics
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ONSdigital/dp-frontend-articles-controller/cache"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestUnitMetrics(t *testing.T) {
+	Convey("Given metrics with a local registry", t, func() {
+		m := New(prometheus.NewRegistry())
+
+		Convey("When requests are handled by a route", func() {
+			ok := m.Route("bulletin", func(w http.ResponseWriter, req *http.Request) {
+				w.Write([]byte("a bulletin"))
+			})
+			notFound := m.Route("bulletin", func(w http.ResponseWriter, req *http.Request) {
+				w.WriteHeader(http.StatusNotFound)
+			})
+			ok(httptest.NewRecorder(), httptest.NewRequest("GET", "/a/bulletin", nil))
+			ok(httptest.NewRecorder(), httptest.NewRequest("GET", "/a/bulletin", nil))
+			notFound(httptest.NewRecorder(), httptest.NewRequest("GET", "/not/a/bulletin", nil))
+
+			Convey("Then they are counted by response status", func() {
+				So(testutil.ToFloat64(m.requests.WithLabelValues("bulletin", "200")), ShouldEqual, 2)
+				So(testutil.ToFloat64(m.requests.WithLabelValues("bulletin", "404")), ShouldEqual, 1)
+			})
+
+			Convey("And their latency is observed", func() {
+				So(testutil.CollectAndCount(m.requestDuration), ShouldEqual, 1)
+			})
+		})
+
+		Convey("When a panic is recovered from", func() {
+			m.RecordPanic()
+
+			Convey("Then it is counted", func() {
+				So(testutil.ToFloat64(m.panics), ShouldEqual, 1)
+			})
+		})
+
+		Convey("When a cache is registered", func() {
+			c := cache.New(10, time.Minute, 0)
+			c.Get(context.Background(), "/a/bulletin|legacy-bulletin|en", func(ctx context.Context) (interface{}, error) { return "a bulletin", nil })
+			c.Get(context.Background(), "/a/bulletin|legacy-bulletin|en", func(ctx context.Context) (interface{}, error) { return "a bulletin", nil })
+			m.RegisterCache(c)
+
+			Convey("Then its statistics are served with the other metrics", func() {
+				w := httptest.NewRecorder()
+				m.Handler().ServeHTTP(w, httptest.NewRequest("GET", "/metrics", nil))
+
+				So(w.Code, ShouldEqual, http.StatusOK)
+				body := w.Body.String()
+				So(body, ShouldContainSubstring, "articles_controller_cache_hits_total 1\n")
+				So(body, ShouldContainSubstring, "articles_controller_cache_misses_total 1\n")
+				So(body, ShouldContainSubstring, "articles_controller_cache_entries 1\n")
+			})
+		})
+
+		Convey("When the metrics are served before any requests", func() {
+			w := httptest.NewRecorder()
+			m.Handler().ServeHTTP(w, httptest.NewRequest("GET", "/metrics", nil))
+
+			Convey("Then they are in the Prometheus text format", func() {
+				So(w.Code, ShouldEqual, http.StatusOK)
+				So(strings.HasPrefix(w.Header().Get("Content-Type"), "text/plain"), ShouldBeTrue)
+			})
+		})
+	})
+}
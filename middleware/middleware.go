@@ -0,0 +1,97 @@
+package middleware
+
+import (
+	"net/http"
+	"time"
+
+	dprequest "github.com/ONSdigital/dp-net/v2/request"
+	"github.com/ONSdigital/log.go/v2/log"
+	"github.com/gorilla/mux"
+)
+
+// requestIDSize is the length of the request IDs generated for requests that do not already have one
+const requestIDSize = 16
+
+// RequestID propagates the X-Request-Id header of a request, generating one if it is not set. The request ID is
+// added to the request context, so that it is logged with every event for the request, and is echoed in the
+// response so that it can be quoted to support teams.
+func RequestID(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		requestID := req.Header.Get(dprequest.RequestHeaderKey)
+		if requestID == "" {
+			requestID = dprequest.NewRequestID(requestIDSize)
+			req.Header.Set(dprequest.RequestHeaderKey, requestID)
+		}
+
+		w.Header().Set(dprequest.RequestHeaderKey, requestID)
+		next.ServeHTTP(w, req.WithContext(dprequest.WithRequestId(req.Context(), requestID)))
+	})
+}
+
+// responseCapture records the status and size of a response
+type responseCapture struct {
+	http.ResponseWriter
+	status int
+	bytes  int
+}
+
+func (r *responseCapture) WriteHeader(status int) {
+	if r.status == 0 {
+		r.status = status
+	}
+	r.ResponseWriter.WriteHeader(status)
+}
+
+func (r *responseCapture) Write(b []byte) (int, error) {
+	if r.status == 0 {
+		r.status = http.StatusOK
+	}
+	n, err := r.ResponseWriter.Write(b)
+	r.bytes += n
+	return n, err
+}
+
+// Flush passes on flushes, so that streamed responses such as PDFs are not held back
+func (r *responseCapture) Flush() {
+	if f, ok := r.ResponseWriter.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
+// AccessLog logs a single event for each request to r once it has been handled. It wraps the whole router rather than
+// being router middleware, as the router answers requests that match no route with a 404 or 405 without running its
+// middleware. The route that a request matched is found by matching it against the router again.
+func AccessLog(r *mux.Router) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		start := time.Now()
+		rc := &responseCapture{ResponseWriter: w}
+
+		r.ServeHTTP(rc, req)
+
+		if rc.status == 0 {
+			rc.status = http.StatusOK
+		}
+		collectionID, _ := dprequest.GetCollectionID(req)
+		log.Info(req.Context(), "access", log.Data{
+			"method":        req.Method,
+			"path":          req.URL.Path,
+			"route":         RouteTemplate(r, req),
+			"status":        rc.status,
+			"bytes":         rc.bytes,
+			"duration_ms":   time.Since(start).Milliseconds(),
+			"lang":          dprequest.GetLocaleCode(req),
+			"in_collection": collectionID != "",
+		})
+	})
+}
+
+// RouteTemplate returns the path template of the route in r that matches req, or an empty string if there is none
+func RouteTemplate(r *mux.Router, req *http.Request) string {
+	var match mux.RouteMatch
+	if r.Match(req, &match) && match.MatchErr == nil && match.Route != nil {
+		if template, err := match.Route.GetPathTemplate(); err == nil {
+			return template
+		}
+	}
+	return ""
+}
@@ -0,0 +1,114 @@
+package middleware
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	dprequest "github.com/ONSdigital/dp-net/v2/request"
+	"github.com/ONSdigital/log.go/v2/log"
+	"github.com/gorilla/mux"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestUnitRequestID(t *testing.T) {
+	Convey("Given a handler with the request ID middleware", t, func() {
+		var contextID string
+		h := RequestID(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			contextID = dprequest.GetRequestId(req.Context())
+		}))
+		w := httptest.NewRecorder()
+
+		Convey("When a request without a request ID is handled", func() {
+			h.ServeHTTP(w, httptest.NewRequest("GET", "/a/bulletin", nil))
+
+			Convey("Then a request ID is generated, added to the context and echoed in the response", func() {
+				So(contextID, ShouldHaveLength, requestIDSize)
+				So(w.Header().Get("X-Request-Id"), ShouldEqual, contextID)
+			})
+		})
+
+		Convey("When a request with a request ID is handled", func() {
+			req := httptest.NewRequest("GET", "/a/bulletin", nil)
+			req.Header.Set("X-Request-Id", "upstream-request-id")
+			h.ServeHTTP(w, req)
+
+			Convey("Then the request ID is propagated", func() {
+				So(contextID, ShouldEqual, "upstream-request-id")
+				So(w.Header().Get("X-Request-Id"), ShouldEqual, "upstream-request-id")
+			})
+		})
+	})
+}
+
+func TestUnitAccessLog(t *testing.T) {
+	Convey("Given a router wrapped with the request ID and access log middleware", t, func() {
+		var buf bytes.Buffer
+		log.SetDestination(&buf, nil)
+		defer log.SetDestination(os.Stdout, nil)
+
+		router := mux.NewRouter()
+		router.Path("/admin").Methods("POST").HandlerFunc(func(w http.ResponseWriter, req *http.Request) {})
+		router.Path("/a/{uri:[a-z/]+}").Methods("GET").HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte("not found"))
+		})
+		r := RequestID(AccessLog(router))
+
+		type accessEvent struct {
+			Event   string                 `json:"event"`
+			TraceID string                 `json:"trace_id"`
+			Data    map[string]interface{} `json:"data"`
+		}
+
+		Convey("When a Welsh page in a collection is requested", func() {
+			req := httptest.NewRequest("GET", "http://cy.localhost/a/bulletin", nil)
+			req.Header.Set("X-Request-Id", "a-request-id")
+			req.Header.Set("Collection-Id", "a-collection")
+			r.ServeHTTP(httptest.NewRecorder(), req)
+
+			Convey("Then a single access log event is written for the request", func() {
+				var event accessEvent
+				So(json.Unmarshal(buf.Bytes(), &event), ShouldBeNil)
+				So(event.Event, ShouldEqual, "access")
+				So(event.TraceID, ShouldEqual, "a-request-id")
+				So(event.Data["route"], ShouldEqual, "/a/{uri:[a-z/]+}")
+				So(event.Data["path"], ShouldEqual, "/a/bulletin")
+				So(event.Data["status"], ShouldEqual, http.StatusNotFound)
+				So(event.Data["bytes"], ShouldEqual, len("not found"))
+				So(event.Data["lang"], ShouldEqual, "cy")
+				So(event.Data["in_collection"], ShouldBeTrue)
+				So(event.Data, ShouldContainKey, "duration_ms")
+			})
+		})
+
+		Convey("When a request that matches no route is made", func() {
+			req := httptest.NewRequest("GET", "http://localhost/another/bulletin", nil)
+			req.Header.Set("X-Request-Id", "a-request-id")
+			r.ServeHTTP(httptest.NewRecorder(), req)
+
+			Convey("Then it is logged with the not found status of the router", func() {
+				var event accessEvent
+				So(json.Unmarshal(buf.Bytes(), &event), ShouldBeNil)
+				So(event.Event, ShouldEqual, "access")
+				So(event.TraceID, ShouldEqual, "a-request-id")
+				So(event.Data["route"], ShouldEqual, "")
+				So(event.Data["status"], ShouldEqual, http.StatusNotFound)
+			})
+		})
+
+		Convey("When a route is requested with a method it does not allow", func() {
+			r.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest("GET", "http://localhost/admin", nil))
+
+			Convey("Then it is logged with the method not allowed status of the router", func() {
+				var event accessEvent
+				So(json.Unmarshal(buf.Bytes(), &event), ShouldBeNil)
+				So(event.Event, ShouldEqual, "access")
+				So(event.Data["status"], ShouldEqual, http.StatusMethodNotAllowed)
+			})
+		})
+	})
+}
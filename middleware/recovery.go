@@ -0,0 +1,75 @@
+package middleware
+
+import (
+	"fmt"
+	"net/http"
+	"runtime/debug"
+
+	"github.com/ONSdigital/dp-api-clients-go/v2/zebedee"
+	"github.com/ONSdigital/dp-frontend-articles-controller/handlers"
+	"github.com/ONSdigital/dp-frontend-articles-controller/mapper"
+	dprequest "github.com/ONSdigital/dp-net/v2/request"
+	"github.com/ONSdigital/log.go/v2/log"
+)
+
+// writeTracker records whether any of a response has been written
+type writeTracker struct {
+	http.ResponseWriter
+	written bool
+}
+
+func (w *writeTracker) WriteHeader(status int) {
+	w.written = true
+	w.ResponseWriter.WriteHeader(status)
+}
+
+func (w *writeTracker) Write(b []byte) (int, error) {
+	w.written = true
+	return w.ResponseWriter.Write(b)
+}
+
+// Recovery recovers from panics in handlers, logging the panic with its stack and rendering the internal server
+// error page in the language of the request. onPanic is called for each recovered panic, and may be nil.
+func Recovery(rc handlers.RenderClient, onPanic func()) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			wt := &writeTracker{ResponseWriter: w}
+
+			defer func() {
+				p := recover()
+				if p == nil {
+					return
+				}
+				if p == http.ErrAbortHandler {
+					panic(p)
+				}
+
+				ctx := req.Context()
+				err, ok := p.(error)
+				if !ok {
+					err = fmt.Errorf("%v", p)
+				}
+				log.Error(ctx, "recovered from panic in handler", err, log.Data{
+					"method": req.Method,
+					"path":   req.URL.Path,
+					"stack":  string(debug.Stack()),
+				})
+				if onPanic != nil {
+					onPanic()
+				}
+
+				// Nothing more can be done if the handler had started writing its response
+				if wt.written {
+					return
+				}
+				lang := dprequest.GetLocaleCode(req)
+				model := mapper.CreateErrorModel(rc.NewBasePageModel(), http.StatusInternalServerError, lang, dprequest.GetRequestId(ctx), "", zebedee.EmergencyBanner{})
+				w.Header().Set("Cache-Control", "no-store")
+				w.WriteHeader(http.StatusInternalServerError)
+				rc.BuildPage(w, model, "error-page")
+			}()
+
+			next.ServeHTTP(wt, req)
+		})
+	}
+}
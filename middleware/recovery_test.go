@@ -0,0 +1,96 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ONSdigital/dp-frontend-articles-controller/handlers"
+	"github.com/ONSdigital/dp-frontend-articles-controller/mapper"
+	"github.com/ONSdigital/dp-renderer/helper"
+	coreModel "github.com/ONSdigital/dp-renderer/model"
+	gomock "github.com/golang/mock/gomock"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func init() {
+	// The error page is localised when it is mapped, so the service locale files are read from disk
+	helper.InitialiseLocalisationsHelper(func(name string) ([]byte, error) {
+		return os.ReadFile(filepath.Join("..", "assets", name))
+	})
+}
+
+func TestUnitRecovery(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	Convey("Given handlers wrapped with the recovery middleware", t, func() {
+		mockRenderClient := handlers.NewMockRenderClient(mockCtrl)
+		panics := 0
+		recovery := Recovery(mockRenderClient, func() { panics++ })
+		w := httptest.NewRecorder()
+
+		Convey("When a handler panics", func() {
+			mockRenderClient.EXPECT().NewBasePageModel().Return(coreModel.Page{})
+			mockRenderClient.EXPECT().BuildPage(w, gomock.AssignableToTypeOf(mapper.ErrorModel{}), "error-page").
+				Do(func(_ interface{}, model interface{}, _ string) {
+					So(model.(mapper.ErrorModel).StatusCode, ShouldEqual, http.StatusInternalServerError)
+					So(model.(mapper.ErrorModel).Language, ShouldEqual, "cy")
+				})
+
+			h := recovery(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+				var uris []string
+				_ = uris[1]
+			}))
+			h.ServeHTTP(w, httptest.NewRequest("GET", "http://cy.localhost/a/bulletin", nil))
+
+			Convey("Then the localised internal server error page is rendered", func() {
+				So(w.Code, ShouldEqual, http.StatusInternalServerError)
+				So(w.Header().Get("Cache-Control"), ShouldEqual, "no-store")
+			})
+
+			Convey("And the panic is counted", func() {
+				So(panics, ShouldEqual, 1)
+			})
+		})
+
+		Convey("When a handler panics after writing its response", func() {
+			h := recovery(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+				w.Write([]byte("partial"))
+				panic("failed to stream response")
+			}))
+			h.ServeHTTP(w, httptest.NewRequest("GET", "/a/bulletin/pdf", nil))
+
+			Convey("Then the error page is not rendered over the response", func() {
+				So(w.Code, ShouldEqual, http.StatusOK)
+				So(w.Body.String(), ShouldEqual, "partial")
+				So(panics, ShouldEqual, 1)
+			})
+		})
+
+		Convey("When a handler aborts", func() {
+			h := recovery(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+				panic(http.ErrAbortHandler)
+			}))
+
+			Convey("Then the abort is passed on to the server", func() {
+				So(func() { h.ServeHTTP(w, httptest.NewRequest("GET", "/a/bulletin", nil)) }, ShouldPanicWith, http.ErrAbortHandler)
+				So(panics, ShouldEqual, 0)
+			})
+		})
+
+		Convey("When a handler does not panic", func() {
+			h := recovery(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+				w.WriteHeader(http.StatusNoContent)
+			}))
+			h.ServeHTTP(w, httptest.NewRequest("GET", "/a/bulletin", nil))
+
+			Convey("Then the response is unchanged", func() {
+				So(w.Code, ShouldEqual, http.StatusNoContent)
+				So(panics, ShouldEqual, 0)
+			})
+		})
+	})
+}
@@ -9,6 +9,8 @@ var cyLocale = []string{
 	"one=\"Ynglŷn â'r data\"",
 	"[AboutTheDataMarkdown]",
 	"one=\"This release includes data from Census 2021 (cy)\"",
+	"[SocialSiteName]",
+	"one=\"Swyddfa Ystadegau Gwladol\"",
 }
 
 var enLocale = []string{
@@ -18,6 +20,8 @@ var enLocale = []string{
 	"one=\"About the data\"",
 	"[AboutTheDataMarkdown]",
 	"one=\"This release includes data from Census 2021\"",
+	"[SocialSiteName]",
+	"one=\"Office for National Statistics\"",
 }
 
 func MockAssetFunction(name string) ([]byte, error) {
@@ -0,0 +1,96 @@
+package pdf
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+
+	dperrors "github.com/ONSdigital/dp-api-clients-go/v2/errors"
+	"github.com/ONSdigital/dp-api-clients-go/v2/headers"
+	dphttp "github.com/ONSdigital/dp-net/v2/http"
+	"github.com/ONSdigital/log.go/v2/log"
+)
+
+// Client is a client for the upstream service that provides PDF versions of pages
+type Client struct {
+	url    string
+	client dphttp.Clienter
+}
+
+// PDF is a PDF being streamed from the upstream service. The caller must close Body.
+type PDF struct {
+	Body               io.ReadCloser
+	ContentType        string
+	ContentDisposition string
+	ContentLength      int64
+}
+
+// New creates a new PDF client for the upstream service at url
+func New(url string) *Client {
+	return NewWithClient(url, dphttp.NewClient())
+}
+
+// NewWithClient creates a new PDF client for the upstream service at url, using the provided http client
+func NewWithClient(url string, client dphttp.Clienter) *Client {
+	return &Client{
+		url:    url,
+		client: client,
+	}
+}
+
+// GetPDF requests the PDF for the page at uri. The upstream response body is returned unread, so that it can be
+// streamed to the caller.
+func (c *Client) GetPDF(ctx context.Context, userAccessToken, collectionID, lang, uri string) (*PDF, error) {
+	reqURL := c.url + uri + "/pdf"
+	if lang != "" {
+		reqURL += "?lang=" + url.QueryEscape(lang)
+	}
+
+	req, err := http.NewRequest("GET", reqURL, nil)
+	if err != nil {
+		return nil, dperrors.New(
+			fmt.Errorf("failed to create request for pdf: %w", err),
+			http.StatusInternalServerError,
+			log.Data{"uri": uri},
+		)
+	}
+
+	headers.SetCollectionID(req, collectionID)
+	headers.SetAuthToken(req, userAccessToken)
+
+	resp, err := c.client.Do(ctx, req)
+	if err != nil {
+		return nil, dperrors.New(
+			fmt.Errorf("failed to get response for pdf: %w", err),
+			http.StatusInternalServerError,
+			log.Data{"uri": uri},
+		)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		closeResponseBody(ctx, resp)
+		return nil, dperrors.New(
+			fmt.Errorf("invalid response for pdf: %d", resp.StatusCode),
+			resp.StatusCode,
+			log.Data{"uri": uri},
+		)
+	}
+
+	return &PDF{
+		Body:               resp.Body,
+		ContentType:        resp.Header.Get("Content-Type"),
+		ContentDisposition: resp.Header.Get("Content-Disposition"),
+		ContentLength:      resp.ContentLength,
+	}, nil
+}
+
+// closeResponseBody closes the response body and logs an error if unsuccessful
+func closeResponseBody(ctx context.Context, resp *http.Response) {
+	if resp.Body != nil {
+		if err := resp.Body.Close(); err != nil {
+			log.Error(ctx, "error closing http response body", err)
+		}
+	}
+}
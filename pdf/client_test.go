@@ -0,0 +1,87 @@
+package pdf
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	dperrors "github.com/ONSdigital/dp-api-clients-go/v2/errors"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestUnitClient(t *testing.T) {
+	ctx := context.Background()
+
+	Convey("Given an upstream that serves a pdf", t, func() {
+		var req *http.Request
+		upstream := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			req = r
+			if r.URL.Path != "/a/bulletin/pdf" {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			w.Header().Set("Content-Type", "application/pdf")
+			w.Header().Set("Content-Disposition", `attachment; filename="bulletin.pdf"`)
+			w.Write([]byte("%PDF-1.4"))
+		}))
+		defer upstream.Close()
+
+		client := New(upstream.URL)
+
+		Convey("When the pdf is requested", func() {
+			pdf, err := client.GetPDF(ctx, "token", "collection", "cy", "/a/bulletin")
+			So(err, ShouldBeNil)
+			defer pdf.Body.Close()
+
+			Convey("Then the response headers are returned", func() {
+				So(pdf.ContentType, ShouldEqual, "application/pdf")
+				So(pdf.ContentDisposition, ShouldEqual, `attachment; filename="bulletin.pdf"`)
+				So(pdf.ContentLength, ShouldEqual, 8)
+			})
+
+			Convey("And the body can be streamed", func() {
+				b, err := io.ReadAll(pdf.Body)
+				So(err, ShouldBeNil)
+				So(string(b), ShouldEqual, "%PDF-1.4")
+			})
+
+			Convey("And the request is made with the caller's collection, token and language", func() {
+				So(req.Header.Get("Collection-Id"), ShouldEqual, "collection")
+				So(req.Header.Get("X-Florence-Token"), ShouldEqual, "token")
+				So(req.URL.Query().Get("lang"), ShouldEqual, "cy")
+			})
+		})
+
+		Convey("When a pdf that does not exist is requested", func() {
+			pdf, err := client.GetPDF(ctx, "", "", "en", "/another/bulletin")
+
+			Convey("Then an error with the upstream status code is returned", func() {
+				So(pdf, ShouldBeNil)
+				So(err, ShouldNotBeNil)
+				So(err.(*dperrors.Error).Code(), ShouldEqual, http.StatusNotFound)
+			})
+		})
+
+		Convey("When the upstream does not respond before the deadline of the call", func() {
+			slow := make(chan struct{})
+			slowUpstream := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				<-slow
+			}))
+			defer slowUpstream.Close()
+			defer close(slow)
+
+			ctx, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+			defer cancel()
+			pdf, err := New(slowUpstream.URL).GetPDF(ctx, "", "", "en", "/a/bulletin")
+
+			Convey("Then the error matches the deadline, so that it is reported as a timeout", func() {
+				So(pdf, ShouldBeNil)
+				So(errors.Is(err, context.DeadlineExceeded), ShouldBeTrue)
+			})
+		})
+	})
+}
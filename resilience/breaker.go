@@ -0,0 +1,158 @@
+package resilience
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/ONSdigital/dp-healthcheck/healthcheck"
+	"github.com/ONSdigital/log.go/v2/log"
+)
+
+// State is the state of a circuit breaker
+type State int
+
+// The states of a circuit breaker
+const (
+	// Closed lets calls through, counting consecutive failures
+	Closed State = iota
+	// Open rejects calls without making them, until the open timeout has passed
+	Open
+	// HalfOpen lets a single trial call through, closing the breaker if it succeeds and opening it again if not
+	HalfOpen
+)
+
+func (s State) String() string {
+	switch s {
+	case Closed:
+		return "closed"
+	case Open:
+		return "open"
+	case HalfOpen:
+		return "half-open"
+	}
+	return fmt.Sprintf("State(%d)", int(s))
+}
+
+// outcome is the outcome of a call, as far as a circuit breaker is concerned
+type outcome int
+
+const (
+	success outcome = iota
+	failure
+	// ignored outcomes say nothing about the health of the upstream service, e.g. when the caller gave up on the call
+	ignored
+)
+
+// OpenError is returned for calls that are rejected by an open circuit breaker. It has the status of a service that
+// is unavailable, so that the caller is asked to retry later.
+type OpenError struct {
+	Service string
+}
+
+func (e *OpenError) Error() string {
+	return fmt.Sprintf("circuit breaker for %s is open", e.Service)
+}
+
+// Code returns the status of the error
+func (e *OpenError) Code() int { return http.StatusServiceUnavailable }
+
+// Breaker is a circuit breaker for an upstream service, which opens after a number of consecutive failed calls so
+// that calls fail fast while the service recovers
+type Breaker struct {
+	service     string
+	threshold   int
+	openTimeout time.Duration
+	now         func() time.Time
+
+	mu       sync.Mutex
+	state    State
+	failures int
+	openedAt time.Time
+	trial    bool
+}
+
+// NewBreaker creates a breaker for service, which opens after threshold consecutive failures and lets a trial call
+// through once it has been open for openTimeout. A threshold of 0 disables the breaker.
+func NewBreaker(service string, threshold int, openTimeout time.Duration) *Breaker {
+	return &Breaker{
+		service:     service,
+		threshold:   threshold,
+		openTimeout: openTimeout,
+		now:         time.Now,
+	}
+}
+
+// State returns the current state of the breaker
+func (b *Breaker) State() State {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.state
+}
+
+// allow returns an *OpenError if a call cannot be made. Every allowed call must be followed by a call to record.
+func (b *Breaker) allow() error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	switch b.state {
+	case Open:
+		if b.now().Sub(b.openedAt) < b.openTimeout {
+			return &OpenError{Service: b.service}
+		}
+		b.state = HalfOpen
+		b.trial = true
+		return nil
+	case HalfOpen:
+		if b.trial {
+			return &OpenError{Service: b.service}
+		}
+		b.trial = true
+		return nil
+	}
+	return nil
+}
+
+// record records the outcome of an allowed call
+func (b *Breaker) record(ctx context.Context, o outcome) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.state == HalfOpen {
+		b.trial = false
+	}
+
+	switch o {
+	case success:
+		if b.state != Closed {
+			log.Info(ctx, "circuit breaker closed", log.Data{"upstream_service": b.service})
+		}
+		b.state = Closed
+		b.failures = 0
+	case failure:
+		b.failures++
+		if b.state == HalfOpen || (b.threshold > 0 && b.failures >= b.threshold) {
+			if b.state != Open {
+				log.Warn(ctx, "circuit breaker opened", log.Data{"upstream_service": b.service, "failures": b.failures})
+			}
+			b.state = Open
+			b.openedAt = b.now()
+		}
+	}
+}
+
+// Checker reports the state of the breaker to the health check. A breaker that is not closed is only a warning, as
+// the upstream service is already checked by its own health check, and a critical status would take this service out
+// of the load balancer while it can still serve pages, e.g. from the cache.
+func (b *Breaker) Checker(ctx context.Context, state *healthcheck.CheckState) error {
+	switch s := b.State(); s {
+	case Open:
+		return state.Update(healthcheck.StatusWarning, fmt.Sprintf("circuit breaker for %s is open", b.service), 0)
+	case HalfOpen:
+		return state.Update(healthcheck.StatusWarning, fmt.Sprintf("circuit breaker for %s is half-open", b.service), 0)
+	default:
+		return state.Update(healthcheck.StatusOK, fmt.Sprintf("circuit breaker for %s is closed", b.service), 0)
+	}
+}
@@ -0,0 +1,125 @@
+package resilience
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ONSdigital/dp-healthcheck/healthcheck"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestUnitBreaker(t *testing.T) {
+	ctx := context.Background()
+
+	Convey("Given a closed breaker with a threshold of 2", t, func() {
+		clock := time.Date(2022, 8, 12, 7, 0, 0, 0, time.UTC)
+		b := NewBreaker("zebedee", 2, time.Minute)
+		b.now = func() time.Time { return clock }
+
+		fail := func() {
+			So(b.allow(), ShouldBeNil)
+			b.record(ctx, failure)
+		}
+
+		Convey("When a call fails", func() {
+			fail()
+
+			Convey("Then the breaker stays closed", func() {
+				So(b.State(), ShouldEqual, Closed)
+				So(b.allow(), ShouldBeNil)
+			})
+		})
+
+		Convey("When calls fail with successes in between", func() {
+			fail()
+			So(b.allow(), ShouldBeNil)
+			b.record(ctx, success)
+			fail()
+
+			Convey("Then the breaker stays closed, as the failures are not consecutive", func() {
+				So(b.State(), ShouldEqual, Closed)
+			})
+		})
+
+		Convey("When consecutive calls fail up to the threshold", func() {
+			fail()
+			fail()
+
+			Convey("Then the breaker opens and rejects calls", func() {
+				So(b.State(), ShouldEqual, Open)
+
+				var openErr *OpenError
+				So(errors.As(b.allow(), &openErr), ShouldBeTrue)
+				So(openErr.Service, ShouldEqual, "zebedee")
+				So(openErr.Code(), ShouldEqual, 503)
+			})
+
+			Convey("And once the open timeout has passed", func() {
+				clock = clock.Add(time.Minute)
+
+				Convey("Then a single trial call is let through", func() {
+					So(b.allow(), ShouldBeNil)
+					So(b.State(), ShouldEqual, HalfOpen)
+					So(b.allow(), ShouldNotBeNil)
+				})
+
+				Convey("Then the breaker closes if the trial call succeeds", func() {
+					So(b.allow(), ShouldBeNil)
+					b.record(ctx, success)
+					So(b.State(), ShouldEqual, Closed)
+					So(b.allow(), ShouldBeNil)
+				})
+
+				Convey("Then the breaker opens again if the trial call fails", func() {
+					fail()
+					So(b.State(), ShouldEqual, Open)
+					So(b.allow(), ShouldNotBeNil)
+				})
+
+				Convey("Then another trial call is let through if the outcome of the trial is not known", func() {
+					So(b.allow(), ShouldBeNil)
+					b.record(ctx, ignored)
+					So(b.State(), ShouldEqual, HalfOpen)
+					So(b.allow(), ShouldBeNil)
+				})
+			})
+		})
+	})
+
+	Convey("Given a breaker with a threshold of 0", t, func() {
+		b := NewBreaker("zebedee", 0, time.Minute)
+
+		Convey("When many calls fail", func() {
+			for i := 0; i < 100; i++ {
+				So(b.allow(), ShouldBeNil)
+				b.record(ctx, failure)
+			}
+
+			Convey("Then the breaker never opens", func() {
+				So(b.State(), ShouldEqual, Closed)
+			})
+		})
+	})
+
+	Convey("The state of a breaker is reported to the health check", t, func() {
+		b := NewBreaker("articles-api", 1, time.Minute)
+		state := healthcheck.NewCheckState("Articles API circuit breaker")
+
+		So(b.Checker(ctx, state), ShouldBeNil)
+		So(state.Status(), ShouldEqual, healthcheck.StatusOK)
+
+		So(b.allow(), ShouldBeNil)
+		b.record(ctx, failure)
+		So(b.Checker(ctx, state), ShouldBeNil)
+		So(state.Status(), ShouldEqual, healthcheck.StatusWarning)
+		So(state.Message(), ShouldEqual, "circuit breaker for articles-api is open")
+
+		b.now = func() time.Time { return time.Now().Add(time.Minute) }
+		So(b.allow(), ShouldBeNil)
+		So(b.Checker(ctx, state), ShouldBeNil)
+		So(state.Status(), ShouldEqual, healthcheck.StatusWarning)
+		So(state.Message(), ShouldEqual, "circuit breaker for articles-api is half-open")
+	})
+}
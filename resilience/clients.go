@@ -0,0 +1,77 @@
+package resilience
+
+import (
+	"context"
+
+	"github.com/ONSdigital/dp-api-clients-go/v2/articles"
+	"github.com/ONSdigital/dp-api-clients-go/v2/zebedee"
+	"github.com/ONSdigital/dp-frontend-articles-controller/handlers"
+)
+
+// ArticlesAPIClient retries calls to the Articles API, through a circuit breaker
+type ArticlesAPIClient struct {
+	handlers.ArticlesApiClient
+	policy Policy
+}
+
+// NewArticlesAPIClient wraps an Articles API client with a retry policy
+func NewArticlesAPIClient(ac handlers.ArticlesApiClient, policy Policy) *ArticlesAPIClient {
+	return &ArticlesAPIClient{ArticlesApiClient: ac, policy: policy}
+}
+
+// GetLegacyBulletin returns a legacy bulletin
+func (c *ArticlesAPIClient) GetLegacyBulletin(ctx context.Context, userAccessToken, collectionID, lang, uri string) (bulletin *articles.Bulletin, err error) {
+	err = c.policy.do(ctx, func(ctx context.Context) (err error) {
+		bulletin, err = c.ArticlesApiClient.GetLegacyBulletin(ctx, userAccessToken, collectionID, lang, uri)
+		return err
+	})
+	return bulletin, err
+}
+
+// ZebedeeClient retries calls to Zebedee, through a circuit breaker
+type ZebedeeClient struct {
+	handlers.ZebedeeClient
+	policy Policy
+}
+
+// NewZebedeeClient wraps a Zebedee client with a retry policy
+func NewZebedeeClient(zc handlers.ZebedeeClient, policy Policy) *ZebedeeClient {
+	return &ZebedeeClient{ZebedeeClient: zc, policy: policy}
+}
+
+// GetBreadcrumb returns the breadcrumb of a page
+func (c *ZebedeeClient) GetBreadcrumb(ctx context.Context, userAccessToken, collectionID, lang, uri string) (breadcrumbs []zebedee.Breadcrumb, err error) {
+	err = c.policy.do(ctx, func(ctx context.Context) (err error) {
+		breadcrumbs, err = c.ZebedeeClient.GetBreadcrumb(ctx, userAccessToken, collectionID, lang, uri)
+		return err
+	})
+	return breadcrumbs, err
+}
+
+// GetHomepageContent returns the content of the homepage. It is not retried, as pages are rendered without it when
+// it is not available.
+func (c *ZebedeeClient) GetHomepageContent(ctx context.Context, userAccessToken, collectionID, lang, path string) (content zebedee.HomepageContent, err error) {
+	err = c.policy.optional().do(ctx, func(ctx context.Context) (err error) {
+		content, err = c.ZebedeeClient.GetHomepageContent(ctx, userAccessToken, collectionID, lang, path)
+		return err
+	})
+	return content, err
+}
+
+// GetPageTitle returns the title of a page
+func (c *ZebedeeClient) GetPageTitle(ctx context.Context, userAccessToken, collectionID, lang, uri string) (title zebedee.PageTitle, err error) {
+	err = c.policy.do(ctx, func(ctx context.Context) (err error) {
+		title, err = c.ZebedeeClient.GetPageTitle(ctx, userAccessToken, collectionID, lang, uri)
+		return err
+	})
+	return title, err
+}
+
+// Get returns the response body of a request to Zebedee
+func (c *ZebedeeClient) Get(ctx context.Context, userAccessToken, path string) (b []byte, err error) {
+	err = c.policy.do(ctx, func(ctx context.Context) (err error) {
+		b, err = c.ZebedeeClient.Get(ctx, userAccessToken, path)
+		return err
+	})
+	return b, err
+}
@@ -0,0 +1,167 @@
+package resilience
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ONSdigital/dp-api-clients-go/v2/articles"
+	dperrors "github.com/ONSdigital/dp-api-clients-go/v2/errors"
+	"github.com/ONSdigital/dp-api-clients-go/v2/zebedee"
+	"github.com/ONSdigital/dp-frontend-articles-controller/handlers"
+	gomock "github.com/golang/mock/gomock"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestUnitClients(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	ctx := context.Background()
+	anyCtx := gomock.Any()
+
+	articlesErr := func(code int) error {
+		return dperrors.New(errors.New("articles api error"), code, nil)
+	}
+	zebedeeErr := func(code int) error {
+		return zebedee.ErrInvalidZebedeeResponse{ActualCode: code, URI: "/parents"}
+	}
+
+	Convey("Given clients with a retry policy of 2 retries", t, func() {
+		mockArticlesApiClient := handlers.NewMockArticlesApiClient(mockCtrl)
+		mockZebedeeClient := handlers.NewMockZebedeeClient(mockCtrl)
+		breaker := NewBreaker("upstream", 10, time.Minute)
+		policy := Policy{Retries: 2, Backoff: time.Millisecond, Breaker: breaker}
+		ac := NewArticlesAPIClient(mockArticlesApiClient, policy)
+		zc := NewZebedeeClient(mockZebedeeClient, policy)
+
+		Convey("When a call fails with a server error and then succeeds", func() {
+			gomock.InOrder(
+				mockArticlesApiClient.EXPECT().GetLegacyBulletin(anyCtx, "", "", "en", "/a/bulletin").Return(nil, articlesErr(502)),
+				mockArticlesApiClient.EXPECT().GetLegacyBulletin(anyCtx, "", "", "en", "/a/bulletin").Return(&articles.Bulletin{URI: "/a/bulletin"}, nil),
+			)
+
+			bulletin, err := ac.GetLegacyBulletin(ctx, "", "", "en", "/a/bulletin")
+
+			Convey("Then it is retried", func() {
+				So(err, ShouldBeNil)
+				So(bulletin.URI, ShouldEqual, "/a/bulletin")
+				So(breaker.State(), ShouldEqual, Closed)
+			})
+		})
+
+		Convey("When a call keeps timing out", func() {
+			mockZebedeeClient.EXPECT().GetBreadcrumb(anyCtx, "", "", "en", "/a/bulletin").Return(nil, context.DeadlineExceeded).Times(3)
+
+			_, err := zc.GetBreadcrumb(ctx, "", "", "en", "/a/bulletin")
+
+			Convey("Then the error of the last retry is returned", func() {
+				So(errors.Is(err, context.DeadlineExceeded), ShouldBeTrue)
+			})
+		})
+
+		Convey("When a call fails with a client error", func() {
+			mockZebedeeClient.EXPECT().GetBreadcrumb(anyCtx, "", "", "en", "/a/bulletin").Return(nil, zebedeeErr(404))
+
+			_, err := zc.GetBreadcrumb(ctx, "", "", "en", "/a/bulletin")
+
+			Convey("Then it is not retried", func() {
+				So(err, ShouldResemble, zebedeeErr(404))
+			})
+		})
+
+		Convey("When the homepage content fails with a server error", func() {
+			mockZebedeeClient.EXPECT().GetHomepageContent(anyCtx, "", "", "en", "/").Return(zebedee.HomepageContent{}, zebedeeErr(500))
+
+			_, err := zc.GetHomepageContent(ctx, "", "", "en", "/")
+
+			Convey("Then it is not retried, as the page does not wait for it", func() {
+				So(err, ShouldResemble, zebedeeErr(500))
+			})
+		})
+
+		Convey("When the caller gives up on a call", func() {
+			ctx, cancel := context.WithCancel(ctx)
+			mockZebedeeClient.EXPECT().Get(anyCtx, "", "/data?uri=/a").DoAndReturn(func(ctx context.Context, userAccessToken, path string) ([]byte, error) {
+				cancel()
+				return nil, ctx.Err()
+			})
+
+			_, err := zc.Get(ctx, "", "/data?uri=/a")
+
+			Convey("Then it is not retried", func() {
+				So(errors.Is(err, context.Canceled), ShouldBeTrue)
+			})
+		})
+	})
+
+	Convey("Given a client with a breaker that opens after 3 failures", t, func() {
+		mockZebedeeClient := handlers.NewMockZebedeeClient(mockCtrl)
+		breaker := NewBreaker("zebedee", 3, time.Minute)
+		zc := NewZebedeeClient(mockZebedeeClient, Policy{Retries: 5, Backoff: time.Millisecond, Breaker: breaker})
+
+		Convey("When a call keeps failing", func() {
+			mockZebedeeClient.EXPECT().GetPageTitle(anyCtx, "", "", "en", "/a").Return(zebedee.PageTitle{}, zebedeeErr(503)).Times(3)
+
+			_, err := zc.GetPageTitle(ctx, "", "", "en", "/a")
+
+			Convey("Then the breaker opens and stops the retries", func() {
+				So(breaker.State(), ShouldEqual, Open)
+				var openErr *OpenError
+				So(errors.As(err, &openErr), ShouldBeTrue)
+			})
+
+			Convey("And later calls fail fast without reaching Zebedee", func() {
+				_, err := zc.GetBreadcrumb(ctx, "", "", "en", "/a")
+				var openErr *OpenError
+				So(errors.As(err, &openErr), ShouldBeTrue)
+			})
+		})
+
+		Convey("When the homepage content keeps timing out", func() {
+			mockZebedeeClient.EXPECT().GetHomepageContent(anyCtx, "", "", "en", "/").Return(zebedee.HomepageContent{}, context.DeadlineExceeded).Times(5)
+
+			for i := 0; i < 5; i++ {
+				zc.GetHomepageContent(ctx, "", "", "en", "/")
+			}
+
+			Convey("Then the breaker stays closed, as its deadline is shorter than other calls", func() {
+				So(breaker.State(), ShouldEqual, Closed)
+			})
+		})
+	})
+}
+
+func TestUnitBackoff(t *testing.T) {
+	Convey("The backoff before a retry is jittered up to a maximum that doubles for each retry", t, func() {
+		p := Policy{Backoff: 100 * time.Millisecond}
+		for attempt := 0; attempt < 3; attempt++ {
+			max := 100 * time.Millisecond << attempt
+			for i := 0; i < 100; i++ {
+				d := p.backoff(attempt)
+				So(d, ShouldBeGreaterThanOrEqualTo, 0)
+				So(d, ShouldBeLessThan, max)
+			}
+		}
+	})
+
+	Convey("There is no backoff when it is 0", t, func() {
+		So(Policy{}.backoff(2), ShouldEqual, 0)
+	})
+}
+
+func TestUnitIsFailure(t *testing.T) {
+	Convey("Server errors, timeouts and errors without a status are failures of the upstream service", t, func() {
+		So(IsFailure(dperrors.New(errors.New("articles api error"), 500, nil)), ShouldBeTrue)
+		So(IsFailure(zebedee.ErrInvalidZebedeeResponse{ActualCode: 502, URI: "/parents"}), ShouldBeTrue)
+		So(IsFailure(&OpenError{Service: "zebedee"}), ShouldBeTrue)
+		So(IsFailure(context.DeadlineExceeded), ShouldBeTrue)
+		So(IsFailure(errors.New("connection refused")), ShouldBeTrue)
+	})
+
+	Convey("Client errors are not failures of the upstream service", t, func() {
+		So(IsFailure(nil), ShouldBeFalse)
+		So(IsFailure(dperrors.New(errors.New("not found"), 404, nil)), ShouldBeFalse)
+		So(IsFailure(zebedee.ErrInvalidZebedeeResponse{ActualCode: 404, URI: "/parents"}), ShouldBeFalse)
+	})
+}
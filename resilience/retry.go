@@ -0,0 +1,107 @@
+package resilience
+
+import (
+	"context"
+	"math/rand"
+	"net/http"
+	"time"
+
+	"github.com/ONSdigital/dp-frontend-articles-controller/upstream"
+)
+
+// Policy is how calls to an upstream service are retried, and the breaker that they go through. Every attempt at a
+// call goes through the breaker, so that an open breaker stops the retries.
+type Policy struct {
+	// Retries is the number of times a failed call is retried
+	Retries int
+	// Backoff is the maximum wait before the first retry, which doubles for each retry after it. The wait before a
+	// retry is a random duration up to the maximum, so that callers that failed together do not retry together.
+	Backoff time.Duration
+	Breaker *Breaker
+
+	// ignoreTimeouts is set for optional calls, which have deadlines that are short of what a healthy service
+	// may take to respond
+	ignoreTimeouts bool
+}
+
+// optional returns the policy for calls that a page can be rendered without. They are not retried, so that the page
+// does not wait for them, and their timeouts do not count towards opening the breaker.
+func (p Policy) optional() Policy {
+	p.Retries = 0
+	p.ignoreTimeouts = true
+	return p
+}
+
+// do makes a call, retrying it while it fails with an error that the upstream service may recover from
+func (p Policy) do(ctx context.Context, call func(ctx context.Context) error) error {
+	for attempt := 0; ; attempt++ {
+		if err := p.Breaker.allow(); err != nil {
+			return err
+		}
+
+		err := call(ctx)
+		o := getOutcome(ctx, err)
+		if o == failure && p.ignoreTimeouts && upstream.IsTimeout(err) {
+			o = ignored
+		}
+		p.Breaker.record(ctx, o)
+
+		if o != failure || attempt >= p.Retries {
+			return err
+		}
+		if !sleep(ctx, p.backoff(attempt)) {
+			return err
+		}
+	}
+}
+
+// backoff returns the wait before a retry, with full jitter
+func (p Policy) backoff(attempt int) time.Duration {
+	max := p.Backoff << attempt
+	if max <= 0 {
+		return 0
+	}
+	return time.Duration(rand.Int63n(int64(max)))
+}
+
+// sleep waits for d, returning false if ctx is done first
+func sleep(ctx context.Context, d time.Duration) bool {
+	t := time.NewTimer(d)
+	defer t.Stop()
+
+	select {
+	case <-t.C:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
+
+// getOutcome classifies the error of a call
+func getOutcome(ctx context.Context, err error) outcome {
+	if err == nil {
+		return success
+	}
+	// The caller gave up on the call, so it is not known whether the service would have responded
+	if ctx.Err() != nil {
+		return ignored
+	}
+	if IsFailure(err) {
+		return failure
+	}
+	return success
+}
+
+// IsFailure reports whether an error is a failure of the upstream service: a server error, a timeout or an error
+// without a status, such as a refused connection. Other statuses, e.g. a 404, are responses from a healthy service.
+func IsFailure(err error) bool {
+	if err == nil {
+		return false
+	}
+	if upstream.IsTimeout(err) {
+		return true
+	}
+
+	status, ok := upstream.Status(err)
+	return !ok || status >= http.StatusInternalServerError
+}
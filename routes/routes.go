@@ -6,8 +6,17 @@ import (
 
 	"github.com/ONSdigital/dp-api-clients-go/v2/articles"
 	"github.com/ONSdigital/dp-api-clients-go/v2/zebedee"
+	"github.com/ONSdigital/dp-frontend-articles-controller/cache"
 	"github.com/ONSdigital/dp-frontend-articles-controller/config"
 	"github.com/ONSdigital/dp-frontend-articles-controller/handlers"
+	"github.com/ONSdigital/dp-frontend-articles-controller/invalidation"
+	"github.com/ONSdigital/dp-frontend-articles-controller/metrics"
+	"github.com/ONSdigital/dp-frontend-articles-controller/middleware"
+	"github.com/ONSdigital/dp-frontend-articles-controller/pdf"
+	"github.com/ONSdigital/dp-frontend-articles-controller/resilience"
+	"github.com/ONSdigital/dp-frontend-articles-controller/stale"
+	"github.com/ONSdigital/dp-frontend-articles-controller/timeout"
+	"github.com/ONSdigital/dp-frontend-articles-controller/tracing"
 	render "github.com/ONSdigital/dp-renderer"
 	"github.com/ONSdigital/log.go/v2/log"
 	"github.com/gorilla/mux"
@@ -19,13 +28,69 @@ type Clients struct {
 	Zebedee            *zebedee.Client
 	Render             *render.Render
 	ArticlesAPI        *articles.Client
+	PDF                *pdf.Client
+	Cache              *cache.Cache
+	Stale              *stale.Store
+	Invalidator        *invalidation.Invalidator
+	Metrics            *metrics.Metrics
+	ZebedeeBreaker     *resilience.Breaker
+	ArticlesAPIBreaker *resilience.Breaker
 }
 
-// Setup registers routes for the service
-func Setup(ctx context.Context, r *mux.Router, cfg *config.Config, c Clients) {
+// Setup registers routes for the service, returning the handler that serves them with tracing, request IDs and access
+// logs
+func Setup(ctx context.Context, r *mux.Router, cfg *config.Config, c Clients) http.Handler {
 	log.Info(ctx, "adding routes")
+
+	var zc handlers.ZebedeeClient = tracing.NewZebedeeClient(timeout.NewZebedeeClient(c.Zebedee, cfg.ZebedeeTimeout, cfg.HomepageContentTimeout))
+	var ac handlers.ArticlesApiClient = tracing.NewArticlesAPIClient(timeout.NewArticlesAPIClient(c.ArticlesAPI, cfg.ArticlesAPITimeout))
+	var pc handlers.PDFClient = tracing.NewPDFClient(timeout.NewPDFClient(c.PDF, cfg.PDFServiceTimeout))
+	var rc handlers.RenderClient = c.Render
+	route := func(name string, h http.HandlerFunc) http.HandlerFunc { return h }
+	var onPanic func()
+	if c.Metrics != nil {
+		zc = c.Metrics.NewZebedeeClient(zc)
+		ac = c.Metrics.NewArticlesAPIClient(ac)
+		pc = c.Metrics.NewPDFClient(pc)
+		rc = c.Metrics.NewRenderClient(rc)
+		route = c.Metrics.Route
+		onPanic = c.Metrics.RecordPanic
+	}
+	zc = resilience.NewZebedeeClient(zc, resilience.Policy{Retries: cfg.UpstreamRetries, Backoff: cfg.UpstreamRetryBackoff, Breaker: c.ZebedeeBreaker})
+	ac = resilience.NewArticlesAPIClient(ac, resilience.Policy{Retries: cfg.UpstreamRetries, Backoff: cfg.UpstreamRetryBackoff, Breaker: c.ArticlesAPIBreaker})
+	if c.Cache != nil {
+		zc = cache.NewZebedeeClient(zc, c.Cache)
+		ac = cache.NewArticlesAPIClient(ac, c.Cache)
+	}
+	if c.Stale != nil {
+		zc = stale.NewZebedeeClient(zc, c.Stale)
+		ac = stale.NewArticlesAPIClient(ac, c.Stale)
+	}
+
+	r.Use(middleware.Recovery(rc, onPanic), stale.Middleware)
 	r.StrictSlash(true).Path("/health").HandlerFunc(c.HealthCheckHandler)
-	r.StrictSlash(true).Path("/sixteens{uri:/.*}").Methods("GET").HandlerFunc(handlers.SixteensBulletin(*cfg, c.Render, c.Zebedee, c.ArticlesAPI))
-	r.StrictSlash(true).Path("/{uri:.*}/data").Methods("GET").HandlerFunc(handlers.BulletinData(*cfg, c.ArticlesAPI))
-	r.StrictSlash(true).Path("/{uri:.*}").Methods("GET").HandlerFunc(handlers.Bulletin(*cfg, c.Render, c.Zebedee, c.ArticlesAPI))
+	if c.Metrics != nil {
+		r.StrictSlash(true).Path("/metrics").Methods("GET").Handler(c.Metrics.Handler())
+	}
+	r.StrictSlash(true).Path("/sixteens{uri:/.*}").Methods("GET").HandlerFunc(route("sixteens", handlers.SixteensBulletin(*cfg, rc, zc, ac)))
+	r.StrictSlash(true).Path("/{uri:.*}/pdf").Methods("GET").HandlerFunc(route("pdf", handlers.PDF(*cfg, rc, zc, ac, pc)))
+	r.StrictSlash(true).Path("/{uri:.*}/data").Methods("GET").HandlerFunc(route("data", handlers.BulletinData(*cfg, ac)))
+	r.StrictSlash(true).Path("/{uri:.*}").Methods("GET").HandlerFunc(route("bulletin", handlers.Page(*cfg, rc, zc, ac)))
+
+	return tracing.Middleware(r, middleware.RequestID(middleware.AccessLog(r)))
+}
+
+// SetupAdmin registers the routes for operating the service, which are served on their own listener so that they are
+// not reachable by the public. The handler that serves them with request IDs and access logs is returned.
+func SetupAdmin(ctx context.Context, r *mux.Router, c Clients) http.Handler {
+	log.Info(ctx, "adding admin routes")
+
+	if c.Cache != nil {
+		r.StrictSlash(true).Path("/cache/stats").Methods("GET").HandlerFunc(c.Cache.StatsHandler)
+	}
+	if c.Invalidator != nil {
+		r.StrictSlash(true).Path("/cache/invalidate").Methods("POST").HandlerFunc(c.Invalidator.Handler)
+	}
+
+	return middleware.RequestID(middleware.AccessLog(r))
 }
@@ -7,6 +7,7 @@ import (
 	"github.com/ONSdigital/dp-api-clients-go/v2/health"
 	"github.com/ONSdigital/dp-api-clients-go/v2/renderer"
 	"github.com/ONSdigital/dp-frontend-articles-controller/config"
+	"github.com/ONSdigital/dp-frontend-articles-controller/tracing"
 	"github.com/ONSdigital/dp-healthcheck/healthcheck"
 	dphttp "github.com/ONSdigital/dp-net/v2/http"
 )
@@ -62,7 +63,7 @@ func (e *Init) DoGetHTTPServer(bindAddr string, router http.Handler) HTTPServer
 
 // DoGetHealthClient creates a new Health Client for the provided name and url
 func (e *Init) DoGetHealthClient(name, url string) *health.Client {
-	return health.NewClient(name, url)
+	return health.NewClientWithClienter(name, url, tracing.NewHTTPClient())
 }
 
 // DoGetHealthCheck creates a healthcheck with versionInfo
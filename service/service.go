@@ -7,11 +7,21 @@ import (
 	"github.com/ONSdigital/dp-api-clients-go/v2/articles"
 	"github.com/ONSdigital/dp-api-clients-go/v2/zebedee"
 	"github.com/ONSdigital/dp-frontend-articles-controller/assets"
+	"github.com/ONSdigital/dp-frontend-articles-controller/cache"
 	"github.com/ONSdigital/dp-frontend-articles-controller/config"
+	"github.com/ONSdigital/dp-frontend-articles-controller/invalidation"
+	"github.com/ONSdigital/dp-frontend-articles-controller/metrics"
+	"github.com/ONSdigital/dp-frontend-articles-controller/pdf"
+	"github.com/ONSdigital/dp-frontend-articles-controller/resilience"
 	"github.com/ONSdigital/dp-frontend-articles-controller/routes"
+	"github.com/ONSdigital/dp-frontend-articles-controller/stale"
+	"github.com/ONSdigital/dp-frontend-articles-controller/tracing"
+	"github.com/ONSdigital/dp-frontend-articles-controller/upstream"
 	render "github.com/ONSdigital/dp-renderer"
 	"github.com/ONSdigital/log.go/v2/log"
 	"github.com/gorilla/mux"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
 )
 
 var (
@@ -23,12 +33,17 @@ var (
 	Version string
 )
 
-// Service contains the healthcheck, server and serviceList for the controller
+// Service contains the healthcheck, servers and serviceList for the controller
 type Service struct {
 	Config      *config.Config
 	HealthCheck HealthChecker
 	Server      HTTPServer
+	AdminServer HTTPServer
 	ServiceList *ExternalServiceList
+
+	invalidator      *invalidation.Invalidator
+	stopInvalidation context.CancelFunc
+	shutdownTracing  func(context.Context) error
 }
 
 // New creates a new service
@@ -43,6 +58,12 @@ func (svc *Service) Init(ctx context.Context, cfg *config.Config, serviceList *E
 	svc.Config = cfg
 	svc.ServiceList = serviceList
 
+	// Initialise tracing, before any clients that propagate the trace context are created
+	if svc.shutdownTracing, err = tracing.Init(ctx, cfg); err != nil {
+		log.Error(ctx, "failed to initialise tracing", err)
+		return err
+	}
+
 	// Get health client for api router
 	routerHealthClient := serviceList.GetHealthClient("api-router", cfg.APIRouterURL)
 
@@ -51,6 +72,37 @@ func (svc *Service) Init(ctx context.Context, cfg *config.Config, serviceList *E
 		Render:      render.NewWithDefaultClient(assets.Asset, assets.AssetNames, cfg.PatternLibraryAssetsPath, cfg.SiteDomain),
 		Zebedee:     zebedee.NewWithHealthClient(routerHealthClient),
 		ArticlesAPI: articles.NewWithHealthClient(routerHealthClient),
+		PDF:         pdf.NewWithClient(cfg.PDFServiceURL, tracing.NewHTTPClient()),
+
+		ZebedeeBreaker:     resilience.NewBreaker(upstream.Zebedee, cfg.CircuitBreakerThreshold, cfg.CircuitBreakerOpenTimeout),
+		ArticlesAPIBreaker: resilience.NewBreaker(upstream.ArticlesAPI, cfg.CircuitBreakerThreshold, cfg.CircuitBreakerOpenTimeout),
+	}
+	if cfg.CacheSize > 0 {
+		clients.Cache = cache.New(cfg.CacheSize, cfg.CacheTTL, cfg.CacheLoadTimeout)
+	}
+	if cfg.StaleContentSize > 0 {
+		clients.Stale = stale.NewStore(cfg.StaleContentSize, cfg.StaleContentMaxAge)
+	}
+
+	// Content kept in memory is evicted when it may be out of date, from whichever of the stores are enabled
+	var evicters []invalidation.Evicter
+	if clients.Cache != nil {
+		evicters = append(evicters, clients.Cache)
+	}
+	if clients.Stale != nil {
+		evicters = append(evicters, clients.Stale)
+	}
+	if len(evicters) > 0 {
+		clients.Invalidator = invalidation.New(evicters...)
+		svc.invalidator = clients.Invalidator
+	}
+
+	// Initialise metrics, with their own registry so that the service can be initialised more than once
+	registry := prometheus.NewRegistry()
+	registry.MustRegister(collectors.NewGoCollector(), collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}))
+	clients.Metrics = metrics.New(registry)
+	if clients.Cache != nil {
+		clients.Metrics.RegisterCache(clients.Cache)
 	}
 
 	// Get healthcheck with checkers
@@ -67,8 +119,13 @@ func (svc *Service) Init(ctx context.Context, cfg *config.Config, serviceList *E
 
 	// Initialise router
 	r := mux.NewRouter()
-	routes.Setup(ctx, r, cfg, clients)
-	svc.Server = serviceList.GetHTTPServer(cfg.BindAddr, r)
+	svc.Server = serviceList.GetHTTPServer(cfg.BindAddr, routes.Setup(ctx, r, cfg, clients))
+
+	// Initialise admin router, unless it is disabled
+	if cfg.AdminBindAddr != "" {
+		adminRouter := mux.NewRouter()
+		svc.AdminServer = serviceList.GetHTTPServer(cfg.AdminBindAddr, routes.SetupAdmin(ctx, adminRouter, clients))
+	}
 
 	return nil
 }
@@ -88,6 +145,24 @@ func (svc *Service) Run(ctx context.Context, svcErrors chan error) {
 			svcErrors <- err
 		}
 	}()
+
+	// Start admin HTTP server
+	if svc.AdminServer != nil {
+		log.Info(ctx, "Starting admin server")
+		go func() {
+			if err := svc.AdminServer.ListenAndServe(); err != nil {
+				log.Fatal(ctx, "failed to start admin http listen and serve", err)
+				svcErrors <- err
+			}
+		}()
+	}
+
+	// Evict content kept in memory at each release time
+	if svc.invalidator != nil {
+		var invalidationCtx context.Context
+		invalidationCtx, svc.stopInvalidation = context.WithCancel(ctx)
+		go svc.invalidator.Schedule(invalidationCtx, svc.Config.ReleaseTimes)
+	}
 }
 
 // Close gracefully shuts the service down in the required order, with timeout
@@ -103,6 +178,11 @@ func (svc *Service) Close(ctx context.Context) error {
 		log.Info(ctx, "stop health checkers")
 		svc.HealthCheck.Stop()
 
+		// stop evicting content at release times
+		if svc.stopInvalidation != nil {
+			svc.stopInvalidation()
+		}
+
 		// TODO: close any backing services here, e.g. client connections to databases
 
 		// stop any incoming requests
@@ -110,6 +190,20 @@ func (svc *Service) Close(ctx context.Context) error {
 			log.Error(ctx, "failed to shutdown http server", err)
 			hasShutdownError = true
 		}
+		if svc.AdminServer != nil {
+			if err := svc.AdminServer.Shutdown(ctx); err != nil {
+				log.Error(ctx, "failed to shutdown admin http server", err)
+				hasShutdownError = true
+			}
+		}
+
+		// flush any spans from the requests
+		if svc.shutdownTracing != nil {
+			if err := svc.shutdownTracing(ctx); err != nil {
+				log.Error(ctx, "failed to shutdown tracing", err)
+				hasShutdownError = true
+			}
+		}
 	}()
 
 	// wait for shutdown success (via cancel) or failure (timeout)
@@ -145,6 +239,16 @@ func (svc *Service) registerCheckers(ctx context.Context, c routes.Clients) (err
 		log.Error(ctx, "failed to add articles API checker", err)
 	}
 
+	if err = svc.HealthCheck.AddCheck("Zebedee circuit breaker", c.ZebedeeBreaker.Checker); err != nil {
+		hasErrors = true
+		log.Error(ctx, "failed to add Zebedee circuit breaker checker", err)
+	}
+
+	if err = svc.HealthCheck.AddCheck("Articles API circuit breaker", c.ArticlesAPIBreaker.Checker); err != nil {
+		hasErrors = true
+		log.Error(ctx, "failed to add articles API circuit breaker checker", err)
+	}
+
 	if hasErrors {
 		return errors.New("Error(s) registering checkers for healthcheck")
 	}
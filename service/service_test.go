@@ -106,6 +106,7 @@ func TestInitSuccess(t *testing.T) {
 					So(svc.Config, ShouldResemble, cfg)
 					So(svc.HealthCheck, ShouldResemble, hcMock)
 					So(svc.Server, ShouldResemble, serverMock)
+					So(svc.AdminServer, ShouldResemble, serverMock)
 					So(svc.ServiceList, ShouldResemble, mockServiceList)
 
 					Convey("And returns no errors", func() {
@@ -113,9 +114,10 @@ func TestInitSuccess(t *testing.T) {
 
 						Convey("And the checkers are registered and the healthcheck", func() {
 							So(mockServiceList.HealthCheck, ShouldBeTrue)
-							So(len(hcMock.AddCheckCalls()), ShouldEqual, 2)
-							So(len(initMock.DoGetHTTPServerCalls()), ShouldEqual, 1)
+							So(len(hcMock.AddCheckCalls()), ShouldEqual, 4)
+							So(len(initMock.DoGetHTTPServerCalls()), ShouldEqual, 2)
 							So(initMock.DoGetHTTPServerCalls()[0].BindAddr, ShouldEqual, ":26500")
+							So(initMock.DoGetHTTPServerCalls()[1].BindAddr, ShouldEqual, "localhost:26501")
 						})
 					})
 				})
@@ -198,9 +200,11 @@ func TestInitFailure(t *testing.T) {
 
 						Convey("And all checks try to register", func() {
 							So(mockServiceList.HealthCheck, ShouldBeTrue)
-							So(len(hcMockAddFail.AddCheckCalls()), ShouldEqual, 2)
+							So(len(hcMockAddFail.AddCheckCalls()), ShouldEqual, 4)
 							So(hcMockAddFail.AddCheckCalls()[0].Name, ShouldResemble, "Zebedee")
 							So(hcMockAddFail.AddCheckCalls()[1].Name, ShouldResemble, "Articles API")
+							So(hcMockAddFail.AddCheckCalls()[2].Name, ShouldResemble, "Zebedee circuit breaker")
+							So(hcMockAddFail.AddCheckCalls()[3].Name, ShouldResemble, "Articles API circuit breaker")
 						})
 					})
 				})
@@ -309,12 +313,18 @@ func TestCloseSuccess(t *testing.T) {
 			},
 		}
 
+		adminServerCloseMock := &mocks.HTTPServerMock{
+			ListenAndServeFunc: func() error { return nil },
+			ShutdownFunc:       func(ctx context.Context) error { return nil },
+		}
+
 		serviceList := service.NewServiceList(nil)
 		serviceList.HealthCheck = true
 		svc := service.Service{
 			Config:      cfg,
 			HealthCheck: hcCloseMock,
 			Server:      serverCloseMock,
+			AdminServer: adminServerCloseMock,
 			ServiceList: serviceList,
 		}
 
@@ -325,6 +335,7 @@ func TestCloseSuccess(t *testing.T) {
 				So(err, ShouldBeNil)
 				So(len(hcCloseMock.StopCalls()), ShouldEqual, 1)
 				So(len(serverCloseMock.ShutdownCalls()), ShouldEqual, 1)
+				So(len(adminServerCloseMock.ShutdownCalls()), ShouldEqual, 1)
 			})
 		})
 	})
@@ -0,0 +1,85 @@
+package stale
+
+import (
+	"context"
+
+	"github.com/ONSdigital/dp-api-clients-go/v2/articles"
+	"github.com/ONSdigital/dp-api-clients-go/v2/zebedee"
+	"github.com/ONSdigital/dp-frontend-articles-controller/cache"
+	"github.com/ONSdigital/dp-frontend-articles-controller/handlers"
+)
+
+// ArticlesAPIClient falls back on the last known good copy of published content when the Articles API fails
+type ArticlesAPIClient struct {
+	handlers.ArticlesApiClient
+	store *Store
+}
+
+// NewArticlesAPIClient wraps an Articles API client with the store
+func NewArticlesAPIClient(ac handlers.ArticlesApiClient, s *Store) *ArticlesAPIClient {
+	return &ArticlesAPIClient{
+		ArticlesApiClient: ac,
+		store:             s,
+	}
+}
+
+// GetLegacyBulletin returns a legacy bulletin, from the store if the Articles API fails and it is published content
+func (c *ArticlesAPIClient) GetLegacyBulletin(ctx context.Context, userAccessToken, collectionID, lang, uri string) (*articles.Bulletin, error) {
+	fetchCtx, fetched := cache.WithFetched(ctx)
+	bulletin, err := c.ArticlesApiClient.GetLegacyBulletin(fetchCtx, userAccessToken, collectionID, lang, uri)
+	// Previews are never served stale, as they are expected to show the latest changes
+	if !cache.IsPublished(userAccessToken, collectionID) {
+		return bulletin, err
+	}
+
+	k := cache.Key("legacy-bulletin", lang, uri)
+	if err == nil {
+		if bulletin != nil {
+			c.store.Put(k, cache.CopyBulletin(bulletin), fetched.At())
+		}
+		return bulletin, nil
+	}
+
+	value, ok := c.store.fallback(ctx, k, err)
+	if !ok {
+		return nil, err
+	}
+
+	// Callers are given their own copy, so that the stored bulletin cannot be modified
+	return cache.CopyBulletin(value.(*articles.Bulletin)), nil
+}
+
+// ZebedeeClient falls back on the last known good copy of published content when Zebedee fails
+type ZebedeeClient struct {
+	handlers.ZebedeeClient
+	store *Store
+}
+
+// NewZebedeeClient wraps a Zebedee client with the store
+func NewZebedeeClient(zc handlers.ZebedeeClient, s *Store) *ZebedeeClient {
+	return &ZebedeeClient{
+		ZebedeeClient: zc,
+		store:         s,
+	}
+}
+
+// GetBreadcrumb returns the breadcrumb for a page, from the store if Zebedee fails and it is published content
+func (c *ZebedeeClient) GetBreadcrumb(ctx context.Context, userAccessToken, collectionID, lang, uri string) ([]zebedee.Breadcrumb, error) {
+	fetchCtx, fetched := cache.WithFetched(ctx)
+	breadcrumbs, err := c.ZebedeeClient.GetBreadcrumb(fetchCtx, userAccessToken, collectionID, lang, uri)
+	if !cache.IsPublished(userAccessToken, collectionID) {
+		return breadcrumbs, err
+	}
+
+	k := cache.Key("breadcrumb", lang, uri)
+	if err == nil {
+		c.store.Put(k, append([]zebedee.Breadcrumb(nil), breadcrumbs...), fetched.At())
+		return breadcrumbs, nil
+	}
+
+	value, ok := c.store.fallback(ctx, k, err)
+	if !ok {
+		return nil, err
+	}
+	return append([]zebedee.Breadcrumb(nil), value.([]zebedee.Breadcrumb)...), nil
+}
@@ -0,0 +1,155 @@
+package stale
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ONSdigital/dp-api-clients-go/v2/articles"
+	dperrors "github.com/ONSdigital/dp-api-clients-go/v2/errors"
+	"github.com/ONSdigital/dp-api-clients-go/v2/zebedee"
+	"github.com/ONSdigital/dp-frontend-articles-controller/cache"
+	"github.com/ONSdigital/dp-frontend-articles-controller/handlers"
+	gomock "github.com/golang/mock/gomock"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestUnitClients(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	anyCtx := gomock.Any()
+
+	articlesErr := func(code int) error {
+		return dperrors.New(errors.New("articles api error"), code, nil)
+	}
+	zebedeeErr := func(code int) error {
+		return zebedee.ErrInvalidZebedeeResponse{ActualCode: code, URI: "/parents"}
+	}
+
+	Convey("Given clients wrapped with a store", t, func() {
+		clock := time.Date(2022, 8, 12, 7, 0, 0, 0, time.UTC)
+		s := NewStore(10, time.Hour)
+		s.now = func() time.Time { return clock }
+		mockArticlesApiClient := handlers.NewMockArticlesApiClient(mockCtrl)
+		mockZebedeeClient := handlers.NewMockZebedeeClient(mockCtrl)
+		ac := NewArticlesAPIClient(mockArticlesApiClient, s)
+		zc := NewZebedeeClient(mockZebedeeClient, s)
+
+		m := &marker{}
+		ctx := context.WithValue(context.Background(), contextKey{}, m)
+
+		b := &articles.Bulletin{URI: "/a/bulletin", Type: "bulletin"}
+		bcs := []zebedee.Breadcrumb{{URI: "/"}}
+
+		Convey("When published content was fetched before the upstream services failed", func() {
+			gomock.InOrder(
+				mockArticlesApiClient.EXPECT().GetLegacyBulletin(anyCtx, "", "", "en", "/a/bulletin").Return(b, nil),
+				mockArticlesApiClient.EXPECT().GetLegacyBulletin(anyCtx, "", "", "en", "/a/bulletin").Return(nil, articlesErr(500)),
+			)
+			gomock.InOrder(
+				mockZebedeeClient.EXPECT().GetBreadcrumb(anyCtx, "", "", "en", "/a/bulletin").Return(bcs, nil),
+				mockZebedeeClient.EXPECT().GetBreadcrumb(anyCtx, "", "", "en", "/a/bulletin").Return(nil, context.DeadlineExceeded),
+			)
+
+			_, err := ac.GetLegacyBulletin(context.Background(), "", "", "en", "/a/bulletin")
+			So(err, ShouldBeNil)
+			_, err = zc.GetBreadcrumb(context.Background(), "", "", "en", "/a/bulletin")
+			So(err, ShouldBeNil)
+			clock = clock.Add(5 * time.Minute)
+
+			bulletin, bulletinErr := ac.GetLegacyBulletin(ctx, "", "", "en", "/a/bulletin")
+			breadcrumbs, breadcrumbErr := zc.GetBreadcrumb(ctx, "", "", "en", "/a/bulletin")
+
+			Convey("Then the last known good content is returned", func() {
+				So(bulletinErr, ShouldBeNil)
+				So(bulletin, ShouldResemble, b)
+				So(bulletin, ShouldNotPointTo, b)
+				So(breadcrumbErr, ShouldBeNil)
+				So(breadcrumbs, ShouldResemble, bcs)
+			})
+
+			Convey("Then the response is marked as stale", func() {
+				age, ok := m.get()
+				So(ok, ShouldBeTrue)
+				So(age, ShouldEqual, 5*time.Minute)
+			})
+		})
+
+		Convey("When published content is served from a cache", func() {
+			mockArticlesApiClient.EXPECT().GetLegacyBulletin(anyCtx, "", "", "en", "/a/bulletin").Return(b, nil).Times(1)
+			clock = time.Now()
+			ac := NewArticlesAPIClient(cache.NewArticlesAPIClient(mockArticlesApiClient, cache.New(10, time.Hour, 0)), s)
+
+			ac.GetLegacyBulletin(context.Background(), "", "", "en", "/a/bulletin")
+			clock = clock.Add(5 * time.Minute)
+			ac.GetLegacyBulletin(context.Background(), "", "", "en", "/a/bulletin")
+
+			Convey("Then the age of the content kept is measured from when it was fetched from upstream", func() {
+				_, age, ok := s.Get(cache.Key("legacy-bulletin", "en", "/a/bulletin"))
+				So(ok, ShouldBeTrue)
+				So(age, ShouldBeGreaterThan, 4*time.Minute)
+			})
+		})
+
+		Convey("When published content was fetched in a different language", func() {
+			mockArticlesApiClient.EXPECT().GetLegacyBulletin(anyCtx, "", "", "en", "/a/bulletin").Return(b, nil)
+			mockArticlesApiClient.EXPECT().GetLegacyBulletin(anyCtx, "", "", "cy", "/a/bulletin").Return(nil, articlesErr(500))
+
+			ac.GetLegacyBulletin(context.Background(), "", "", "en", "/a/bulletin")
+			_, err := ac.GetLegacyBulletin(ctx, "", "", "cy", "/a/bulletin")
+
+			Convey("Then the error is returned", func() {
+				So(err, ShouldResemble, articlesErr(500))
+				_, ok := m.get()
+				So(ok, ShouldBeFalse)
+			})
+		})
+
+		Convey("When published content is no longer found", func() {
+			gomock.InOrder(
+				mockZebedeeClient.EXPECT().GetBreadcrumb(anyCtx, "", "", "en", "/a/bulletin").Return(bcs, nil),
+				mockZebedeeClient.EXPECT().GetBreadcrumb(anyCtx, "", "", "en", "/a/bulletin").Return(nil, zebedeeErr(404)),
+			)
+
+			zc.GetBreadcrumb(context.Background(), "", "", "en", "/a/bulletin")
+			_, err := zc.GetBreadcrumb(ctx, "", "", "en", "/a/bulletin")
+
+			Convey("Then the error is returned", func() {
+				So(err, ShouldResemble, zebedeeErr(404))
+			})
+		})
+
+		Convey("When the content kept is older than the maximum age", func() {
+			gomock.InOrder(
+				mockArticlesApiClient.EXPECT().GetLegacyBulletin(anyCtx, "", "", "en", "/a/bulletin").Return(b, nil),
+				mockArticlesApiClient.EXPECT().GetLegacyBulletin(anyCtx, "", "", "en", "/a/bulletin").Return(nil, articlesErr(503)),
+			)
+
+			ac.GetLegacyBulletin(context.Background(), "", "", "en", "/a/bulletin")
+			clock = clock.Add(2 * time.Hour)
+			_, err := ac.GetLegacyBulletin(ctx, "", "", "en", "/a/bulletin")
+
+			Convey("Then the error is returned", func() {
+				So(err, ShouldResemble, articlesErr(503))
+			})
+		})
+
+		Convey("When content in a collection was fetched before the upstream service failed", func() {
+			gomock.InOrder(
+				mockArticlesApiClient.EXPECT().GetLegacyBulletin(anyCtx, "token", "collection", "en", "/a/bulletin").Return(b, nil),
+				mockArticlesApiClient.EXPECT().GetLegacyBulletin(anyCtx, "token", "collection", "en", "/a/bulletin").Return(nil, articlesErr(500)),
+				mockArticlesApiClient.EXPECT().GetLegacyBulletin(anyCtx, "", "", "en", "/a/bulletin").Return(nil, articlesErr(500)),
+			)
+
+			ac.GetLegacyBulletin(context.Background(), "token", "collection", "en", "/a/bulletin")
+			_, previewErr := ac.GetLegacyBulletin(ctx, "token", "collection", "en", "/a/bulletin")
+			_, publishedErr := ac.GetLegacyBulletin(ctx, "", "", "en", "/a/bulletin")
+
+			Convey("Then it is neither kept nor served stale", func() {
+				So(previewErr, ShouldResemble, articlesErr(500))
+				So(publishedErr, ShouldResemble, articlesErr(500))
+			})
+		})
+	})
+}
@@ -0,0 +1,86 @@
+package stale
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// warning is the Warning header of responses rendered from stale content, as defined by RFC 7234
+const warning = `110 - "Response is Stale"`
+
+type contextKey struct{}
+
+// marker records whether any content of a response was served from the store, and the age of the oldest of it
+type marker struct {
+	mu    sync.Mutex
+	stale bool
+	age   time.Duration
+}
+
+func markStale(ctx context.Context, age time.Duration) {
+	m, ok := ctx.Value(contextKey{}).(*marker)
+	if !ok {
+		return
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.stale = true
+	if age > m.age {
+		m.age = age
+	}
+}
+
+func (m *marker) get() (time.Duration, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.age, m.stale
+}
+
+// staleWriter adds the headers of a stale response before its status is written
+type staleWriter struct {
+	http.ResponseWriter
+	marker      *marker
+	wroteHeader bool
+}
+
+func (w *staleWriter) WriteHeader(status int) {
+	if !w.wroteHeader {
+		w.wroteHeader = true
+		if age, ok := w.marker.get(); ok {
+			h := w.ResponseWriter.Header()
+			h.Set("Warning", warning)
+			h.Set("Age", strconv.Itoa(int(age.Seconds())))
+			// Stale pages are not cached, so that the page is fetched again once the upstream service has recovered
+			h.Set("Cache-Control", "no-store")
+			h.Del("ETag")
+		}
+	}
+	w.ResponseWriter.WriteHeader(status)
+}
+
+func (w *staleWriter) Write(b []byte) (int, error) {
+	if !w.wroteHeader {
+		w.WriteHeader(http.StatusOK)
+	}
+	return w.ResponseWriter.Write(b)
+}
+
+// Flush passes on flushes, so that streamed responses such as PDFs are not held back
+func (w *staleWriter) Flush() {
+	if f, ok := w.ResponseWriter.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
+// Middleware adds the Warning and Age headers to responses that were rendered from stale content
+func Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		m := &marker{}
+		ctx := context.WithValue(req.Context(), contextKey{}, m)
+		next.ServeHTTP(&staleWriter{ResponseWriter: w, marker: m}, req.WithContext(ctx))
+	})
+}
@@ -0,0 +1,61 @@
+package stale
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestUnitMiddleware(t *testing.T) {
+	Convey("Given a handler wrapped with the middleware", t, func() {
+		var age time.Duration
+		var stale bool
+		handler := Middleware(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			if stale {
+				markStale(req.Context(), age)
+				markStale(req.Context(), age/2)
+			}
+			w.Header().Set("Cache-Control", "public, max-age=300")
+			w.Header().Set("ETag", `"abc"`)
+			w.Write([]byte("a page"))
+		}))
+		w := httptest.NewRecorder()
+
+		Convey("When the page is rendered from stale content", func() {
+			stale = true
+			age = 90 * time.Second
+			handler.ServeHTTP(w, httptest.NewRequest("GET", "/a/bulletin", nil))
+
+			Convey("Then the response is marked as stale, with the age of the oldest content", func() {
+				So(w.Code, ShouldEqual, http.StatusOK)
+				So(w.Body.String(), ShouldEqual, "a page")
+				So(w.Header().Get("Warning"), ShouldEqual, `110 - "Response is Stale"`)
+				So(w.Header().Get("Age"), ShouldEqual, "90")
+			})
+
+			Convey("Then the response is not cached", func() {
+				So(w.Header().Get("Cache-Control"), ShouldEqual, "no-store")
+				So(w.Header().Get("ETag"), ShouldBeEmpty)
+			})
+		})
+
+		Convey("When the page is rendered from fresh content", func() {
+			handler.ServeHTTP(w, httptest.NewRequest("GET", "/a/bulletin", nil))
+
+			Convey("Then the response is not changed", func() {
+				So(w.Header().Get("Warning"), ShouldBeEmpty)
+				So(w.Header().Get("Age"), ShouldBeEmpty)
+				So(w.Header().Get("Cache-Control"), ShouldEqual, "public, max-age=300")
+				So(w.Header().Get("ETag"), ShouldEqual, `"abc"`)
+			})
+		})
+	})
+
+	Convey("Stale content can be served without the middleware", t, func() {
+		So(func() { markStale(context.Background(), time.Minute) }, ShouldNotPanic)
+	})
+}
@@ -0,0 +1,123 @@
+package stale
+
+import (
+	"container/list"
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ONSdigital/dp-frontend-articles-controller/resilience"
+	"github.com/ONSdigital/log.go/v2/log"
+)
+
+// Store holds the last known good copy of published content, so that pages can still be rendered when an upstream
+// service is briefly unavailable. It holds up to a maximum number of entries, evicting the least recently stored entry
+// when full. Entries are kept after they are too old to be served, until they are replaced or evicted.
+type Store struct {
+	size   int
+	maxAge time.Duration
+	now    func() time.Time
+
+	mu      sync.Mutex
+	entries map[string]*list.Element
+	order   *list.List
+}
+
+type entry struct {
+	key    string
+	value  interface{}
+	stored time.Time
+}
+
+// NewStore creates a store holding up to size entries, which are served for up to maxAge after they were stored
+func NewStore(size int, maxAge time.Duration) *Store {
+	return &Store{
+		size:    size,
+		maxAge:  maxAge,
+		now:     time.Now,
+		entries: make(map[string]*list.Element),
+		order:   list.New(),
+	}
+}
+
+// Put stores value as the last known good copy for key, as fetched from the upstream service at fetched. A zero
+// fetched time is taken to be now. A value fetched no later than the stored copy does not replace it, so that serving
+// a cached value does not make the stored copy look newer than it is.
+func (s *Store) Put(key string, value interface{}, fetched time.Time) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if fetched.IsZero() {
+		fetched = s.now()
+	}
+
+	if el, ok := s.entries[key]; ok {
+		e := el.Value.(*entry)
+		if !fetched.After(e.stored) {
+			return
+		}
+		e.value = value
+		e.stored = fetched
+		s.order.MoveToFront(el)
+		return
+	}
+
+	s.entries[key] = s.order.PushFront(&entry{key: key, value: value, stored: fetched})
+	for s.order.Len() > s.size {
+		oldest := s.order.Back()
+		s.order.Remove(oldest)
+		delete(s.entries, oldest.Value.(*entry).key)
+	}
+}
+
+// Get returns the last known good copy for key and its age, unless there is none or it is older than the maximum age
+func (s *Store) Get(key string) (interface{}, time.Duration, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	el, ok := s.entries[key]
+	if !ok {
+		return nil, 0, false
+	}
+	e := el.Value.(*entry)
+	age := s.now().Sub(e.stored)
+	if age > s.maxAge {
+		return nil, 0, false
+	}
+	return e.value, age, true
+}
+
+// EvictPrefix removes every entry with a key that starts with prefix, returning the number of entries removed. Keys
+// start with the URI of the content, so that the copies of content that has been republished are not served.
+func (s *Store) EvictPrefix(prefix string) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var evicted int
+	for key, el := range s.entries {
+		if strings.HasPrefix(key, prefix) {
+			s.order.Remove(el)
+			delete(s.entries, key)
+			evicted++
+		}
+	}
+	return evicted
+}
+
+// fallback returns the copy for key to serve in place of a failed call, marking the response to the request as stale.
+// Only failures of the upstream service fall back, as other errors, e.g. a 404, are the current state of the content.
+func (s *Store) fallback(ctx context.Context, key string, err error) (interface{}, bool) {
+	if !resilience.IsFailure(err) || ctx.Err() != nil {
+		return nil, false
+	}
+
+	value, age, ok := s.Get(key)
+	if !ok {
+		return nil, false
+	}
+
+	log.Warn(ctx, "serving stale content after upstream failure", log.FormatErrors([]error{err}), log.Data{"key": key, "age_seconds": int(age.Seconds())})
+	markStale(ctx, age)
+	return value, true
+}
@@ -0,0 +1,136 @@
+package stale
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	dperrors "github.com/ONSdigital/dp-api-clients-go/v2/errors"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestUnitStore(t *testing.T) {
+	Convey("Given a store of 2 entries with a maximum age of an hour", t, func() {
+		clock := time.Date(2022, 8, 12, 7, 0, 0, 0, time.UTC)
+		s := NewStore(2, time.Hour)
+		s.now = func() time.Time { return clock }
+
+		Convey("When an entry is stored", func() {
+			s.Put("a", 1, time.Time{})
+			clock = clock.Add(10 * time.Minute)
+
+			Convey("Then it is returned with its age", func() {
+				value, age, ok := s.Get("a")
+				So(ok, ShouldBeTrue)
+				So(value, ShouldEqual, 1)
+				So(age, ShouldEqual, 10*time.Minute)
+			})
+
+			Convey("Then it is replaced when it is stored again", func() {
+				s.Put("a", 2, time.Time{})
+				value, age, ok := s.Get("a")
+				So(ok, ShouldBeTrue)
+				So(value, ShouldEqual, 2)
+				So(age, ShouldEqual, 0)
+			})
+
+			Convey("Then it is not replaced by a copy fetched before it was stored", func() {
+				s.Put("a", 2, clock.Add(-10*time.Minute))
+				value, age, ok := s.Get("a")
+				So(ok, ShouldBeTrue)
+				So(value, ShouldEqual, 1)
+				So(age, ShouldEqual, 10*time.Minute)
+			})
+
+			Convey("Then its age is measured from when it was fetched", func() {
+				s.Put("a", 2, clock.Add(-5*time.Minute))
+				value, age, ok := s.Get("a")
+				So(ok, ShouldBeTrue)
+				So(value, ShouldEqual, 2)
+				So(age, ShouldEqual, 5*time.Minute)
+			})
+
+			Convey("Then it is not returned once it is older than the maximum age", func() {
+				clock = clock.Add(time.Hour)
+				_, _, ok := s.Get("a")
+				So(ok, ShouldBeFalse)
+			})
+		})
+
+		Convey("When more entries are stored than the store holds", func() {
+			for i, k := range []string{"a", "b", "a", "c"} {
+				s.Put(k, i+1, time.Time{})
+				clock = clock.Add(time.Second)
+			}
+
+			Convey("Then the least recently stored entry is evicted", func() {
+				_, _, ok := s.Get("b")
+				So(ok, ShouldBeFalse)
+				value, _, ok := s.Get("a")
+				So(ok, ShouldBeTrue)
+				So(value, ShouldEqual, 3)
+				_, _, ok = s.Get("c")
+				So(ok, ShouldBeTrue)
+			})
+		})
+
+		Convey("When entries are evicted by prefix", func() {
+			s.Put("/a/bulletin|bulletin|en", 1, time.Time{})
+			s.Put("/b/bulletin|bulletin|en", 2, time.Time{})
+			evicted := s.EvictPrefix("/a/")
+
+			Convey("Then only the entries with the prefix are removed", func() {
+				So(evicted, ShouldEqual, 1)
+				_, _, ok := s.Get("/a/bulletin|bulletin|en")
+				So(ok, ShouldBeFalse)
+				_, _, ok = s.Get("/b/bulletin|bulletin|en")
+				So(ok, ShouldBeTrue)
+			})
+
+			Convey("Then entries can be stored up to the size of the store again", func() {
+				s.Put("/c/bulletin|bulletin|en", 3, time.Time{})
+				_, _, ok := s.Get("/b/bulletin|bulletin|en")
+				So(ok, ShouldBeTrue)
+				_, _, ok = s.Get("/c/bulletin|bulletin|en")
+				So(ok, ShouldBeTrue)
+			})
+		})
+
+		Convey("When an entry is not stored", func() {
+			_, _, ok := s.Get("a")
+
+			Convey("Then it is not returned", func() {
+				So(ok, ShouldBeFalse)
+			})
+		})
+	})
+
+	Convey("Given a store with an entry", t, func() {
+		s := NewStore(10, time.Hour)
+		s.Put("a", 1, time.Time{})
+
+		Convey("Then it is served in place of a call that failed with a server error", func() {
+			value, ok := s.fallback(context.Background(), "a", dperrors.New(errors.New("articles api error"), 502, nil))
+			So(ok, ShouldBeTrue)
+			So(value, ShouldEqual, 1)
+		})
+
+		Convey("Then it is served in place of a call that timed out", func() {
+			_, ok := s.fallback(context.Background(), "a", context.DeadlineExceeded)
+			So(ok, ShouldBeTrue)
+		})
+
+		Convey("Then it is not served in place of a call that failed with a client error", func() {
+			_, ok := s.fallback(context.Background(), "a", dperrors.New(errors.New("not found"), 404, nil))
+			So(ok, ShouldBeFalse)
+		})
+
+		Convey("Then it is not served once the caller has given up on the call", func() {
+			ctx, cancel := context.WithCancel(context.Background())
+			cancel()
+			_, ok := s.fallback(ctx, "a", ctx.Err())
+			So(ok, ShouldBeFalse)
+		})
+	})
+}
@@ -0,0 +1,168 @@
+package timeout
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/ONSdigital/dp-api-clients-go/v2/articles"
+	"github.com/ONSdigital/dp-api-clients-go/v2/zebedee"
+	"github.com/ONSdigital/dp-frontend-articles-controller/handlers"
+	"github.com/ONSdigital/dp-frontend-articles-controller/pdf"
+	"github.com/ONSdigital/dp-frontend-articles-controller/upstream"
+)
+
+// Error is returned when an upstream service does not respond before the deadline of a call. It matches
+// context.DeadlineExceeded, so that it is mapped to a gateway timeout whatever error the client wrapped the deadline in.
+type Error struct {
+	Service string
+	Timeout time.Duration
+	Err     error
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("%s did not respond within %s: %v", e.Service, e.Timeout, e.Err)
+}
+
+func (e *Error) Unwrap() error { return e.Err }
+
+// Is reports whether target is context.DeadlineExceeded
+func (e *Error) Is(target error) bool { return target == context.DeadlineExceeded }
+
+// withDeadline makes a call with a context that expires after timeout, unless timeout is 0
+func withDeadline(ctx context.Context, service string, timeout time.Duration, call func(ctx context.Context) error) error {
+	if timeout <= 0 {
+		return call(ctx)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	err := call(ctx)
+	if err != nil && ctx.Err() == context.DeadlineExceeded {
+		return &Error{Service: service, Timeout: timeout, Err: err}
+	}
+	return err
+}
+
+// ArticlesAPIClient sets a deadline on calls to the Articles API
+type ArticlesAPIClient struct {
+	handlers.ArticlesApiClient
+	timeout time.Duration
+}
+
+// NewArticlesAPIClient wraps an Articles API client, with calls that time out after timeout
+func NewArticlesAPIClient(ac handlers.ArticlesApiClient, timeout time.Duration) *ArticlesAPIClient {
+	return &ArticlesAPIClient{ArticlesApiClient: ac, timeout: timeout}
+}
+
+// GetLegacyBulletin returns a legacy bulletin
+func (c *ArticlesAPIClient) GetLegacyBulletin(ctx context.Context, userAccessToken, collectionID, lang, uri string) (bulletin *articles.Bulletin, err error) {
+	err = withDeadline(ctx, upstream.ArticlesAPI, c.timeout, func(ctx context.Context) (err error) {
+		bulletin, err = c.ArticlesApiClient.GetLegacyBulletin(ctx, userAccessToken, collectionID, lang, uri)
+		return err
+	})
+	return bulletin, err
+}
+
+// ZebedeeClient sets a deadline on calls to Zebedee. The homepage content has a deadline of its own, as pages are
+// rendered without it when it is not available in time.
+type ZebedeeClient struct {
+	handlers.ZebedeeClient
+	timeout                time.Duration
+	homepageContentTimeout time.Duration
+}
+
+// NewZebedeeClient wraps a Zebedee client, with calls that time out after timeout, or homepageContentTimeout for the
+// homepage content
+func NewZebedeeClient(zc handlers.ZebedeeClient, timeout, homepageContentTimeout time.Duration) *ZebedeeClient {
+	return &ZebedeeClient{ZebedeeClient: zc, timeout: timeout, homepageContentTimeout: homepageContentTimeout}
+}
+
+// GetBreadcrumb returns the breadcrumb of a page
+func (c *ZebedeeClient) GetBreadcrumb(ctx context.Context, userAccessToken, collectionID, lang, uri string) (breadcrumbs []zebedee.Breadcrumb, err error) {
+	err = withDeadline(ctx, upstream.Zebedee, c.timeout, func(ctx context.Context) (err error) {
+		breadcrumbs, err = c.ZebedeeClient.GetBreadcrumb(ctx, userAccessToken, collectionID, lang, uri)
+		return err
+	})
+	return breadcrumbs, err
+}
+
+// GetHomepageContent returns the content of the homepage
+func (c *ZebedeeClient) GetHomepageContent(ctx context.Context, userAccessToken, collectionID, lang, path string) (content zebedee.HomepageContent, err error) {
+	err = withDeadline(ctx, upstream.Zebedee, c.homepageContentTimeout, func(ctx context.Context) (err error) {
+		content, err = c.ZebedeeClient.GetHomepageContent(ctx, userAccessToken, collectionID, lang, path)
+		return err
+	})
+	return content, err
+}
+
+// GetPageTitle returns the title of a page
+func (c *ZebedeeClient) GetPageTitle(ctx context.Context, userAccessToken, collectionID, lang, uri string) (title zebedee.PageTitle, err error) {
+	err = withDeadline(ctx, upstream.Zebedee, c.timeout, func(ctx context.Context) (err error) {
+		title, err = c.ZebedeeClient.GetPageTitle(ctx, userAccessToken, collectionID, lang, uri)
+		return err
+	})
+	return title, err
+}
+
+// Get returns the response body of a request to Zebedee
+func (c *ZebedeeClient) Get(ctx context.Context, userAccessToken, path string) (b []byte, err error) {
+	err = withDeadline(ctx, upstream.Zebedee, c.timeout, func(ctx context.Context) (err error) {
+		b, err = c.ZebedeeClient.Get(ctx, userAccessToken, path)
+		return err
+	})
+	return b, err
+}
+
+// PDFClient sets a deadline on the response of the PDF service. The deadline does not apply to streaming the body,
+// which can take much longer for a large PDF, so the call is only cancelled once the body has been closed.
+type PDFClient struct {
+	handlers.PDFClient
+	timeout time.Duration
+}
+
+// NewPDFClient wraps a PDF client, with calls that time out after timeout if the PDF service has not responded
+func NewPDFClient(pc handlers.PDFClient, timeout time.Duration) *PDFClient {
+	return &PDFClient{PDFClient: pc, timeout: timeout}
+}
+
+// GetPDF returns the PDF version of a page
+func (c *PDFClient) GetPDF(ctx context.Context, userAccessToken, collectionID, lang, uri string) (*pdf.PDF, error) {
+	if c.timeout <= 0 {
+		return c.PDFClient.GetPDF(ctx, userAccessToken, collectionID, lang, uri)
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	timer := time.AfterFunc(c.timeout, cancel)
+	p, err := c.PDFClient.GetPDF(ctx, userAccessToken, collectionID, lang, uri)
+	if !timer.Stop() {
+		cancel()
+		if p != nil {
+			p.Body.Close()
+		}
+		if err == nil {
+			err = context.DeadlineExceeded
+		}
+		return nil, &Error{Service: upstream.PDF, Timeout: c.timeout, Err: err}
+	}
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+
+	p.Body = &cancelOnClose{ReadCloser: p.Body, cancel: cancel}
+	return p, nil
+}
+
+// cancelOnClose cancels the context of a call when the body of its response is closed
+type cancelOnClose struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (b *cancelOnClose) Close() error {
+	defer b.cancel()
+	return b.ReadCloser.Close()
+}
@@ -0,0 +1,155 @@
+package timeout
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ONSdigital/dp-api-clients-go/v2/articles"
+	"github.com/ONSdigital/dp-api-clients-go/v2/zebedee"
+	"github.com/ONSdigital/dp-frontend-articles-controller/handlers"
+	"github.com/ONSdigital/dp-frontend-articles-controller/pdf"
+	gomock "github.com/golang/mock/gomock"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestUnitClients(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	ctx := context.Background()
+	anyCtx := gomock.Any()
+
+	// slowBulletin waits for the deadline of its call, failing with the context error wrapped the way the Articles API
+	// client wraps it
+	slowBulletin := func(ctx context.Context, userAccessToken, collectionID, lang, uri string) (*articles.Bulletin, error) {
+		<-ctx.Done()
+		return nil, fmt.Errorf("failed to get response from Articles API: %s", ctx.Err())
+	}
+
+	Convey("Given clients wrapped with timeouts", t, func() {
+		mockArticlesApiClient := handlers.NewMockArticlesApiClient(mockCtrl)
+		mockZebedeeClient := handlers.NewMockZebedeeClient(mockCtrl)
+		ac := NewArticlesAPIClient(mockArticlesApiClient, 10*time.Millisecond)
+		zc := NewZebedeeClient(mockZebedeeClient, time.Minute, 10*time.Millisecond)
+		mockPDFClient := handlers.NewMockPDFClient(mockCtrl)
+		pc := NewPDFClient(mockPDFClient, 10*time.Millisecond)
+
+		Convey("When a call responds in time", func() {
+			mockZebedeeClient.EXPECT().GetBreadcrumb(anyCtx, "", "", "en", "/a/bulletin").DoAndReturn(
+				func(ctx context.Context, userAccessToken, collectionID, lang, uri string) ([]zebedee.Breadcrumb, error) {
+					deadline, ok := ctx.Deadline()
+					So(ok, ShouldBeTrue)
+					So(time.Until(deadline), ShouldBeGreaterThan, 10*time.Millisecond)
+					return []zebedee.Breadcrumb{{URI: "/"}}, nil
+				})
+
+			breadcrumbs, err := zc.GetBreadcrumb(ctx, "", "", "en", "/a/bulletin")
+
+			Convey("Then its response is returned", func() {
+				So(err, ShouldBeNil)
+				So(breadcrumbs, ShouldHaveLength, 1)
+			})
+		})
+
+		Convey("When a call does not respond before its deadline", func() {
+			mockArticlesApiClient.EXPECT().GetLegacyBulletin(anyCtx, "", "", "en", "/a/bulletin").DoAndReturn(slowBulletin)
+
+			_, err := ac.GetLegacyBulletin(ctx, "", "", "en", "/a/bulletin")
+
+			Convey("Then a timeout error is returned that matches context.DeadlineExceeded", func() {
+				var timeoutErr *Error
+				So(errors.As(err, &timeoutErr), ShouldBeTrue)
+				So(timeoutErr.Service, ShouldEqual, "articles-api")
+				So(timeoutErr.Timeout, ShouldEqual, 10*time.Millisecond)
+				So(errors.Is(err, context.DeadlineExceeded), ShouldBeTrue)
+			})
+		})
+
+		Convey("When the homepage content does not respond before its own deadline", func() {
+			mockZebedeeClient.EXPECT().GetHomepageContent(anyCtx, "", "", "en", "/").DoAndReturn(
+				func(ctx context.Context, userAccessToken, collectionID, lang, path string) (zebedee.HomepageContent, error) {
+					<-ctx.Done()
+					return zebedee.HomepageContent{}, ctx.Err()
+				})
+
+			_, err := zc.GetHomepageContent(ctx, "", "", "en", "/")
+
+			Convey("Then it times out independently of other calls to Zebedee", func() {
+				So(errors.Is(err, context.DeadlineExceeded), ShouldBeTrue)
+			})
+		})
+
+		Convey("When a call fails before its deadline", func() {
+			upstreamErr := errors.New("zebedee is down")
+			mockZebedeeClient.EXPECT().Get(anyCtx, "", "/data?uri=/a").Return(nil, upstreamErr)
+
+			_, err := zc.Get(ctx, "", "/data?uri=/a")
+
+			Convey("Then its error is returned unchanged", func() {
+				So(err, ShouldEqual, upstreamErr)
+			})
+		})
+
+		Convey("When the timeout is 0", func() {
+			ac := NewArticlesAPIClient(mockArticlesApiClient, 0)
+			mockArticlesApiClient.EXPECT().GetLegacyBulletin(anyCtx, "", "", "en", "/a/bulletin").DoAndReturn(
+				func(ctx context.Context, userAccessToken, collectionID, lang, uri string) (*articles.Bulletin, error) {
+					_, ok := ctx.Deadline()
+					So(ok, ShouldBeFalse)
+					return &articles.Bulletin{}, nil
+				})
+
+			_, err := ac.GetLegacyBulletin(ctx, "", "", "en", "/a/bulletin")
+
+			Convey("Then the call has no deadline", func() {
+				So(err, ShouldBeNil)
+			})
+		})
+
+		Convey("When the PDF service does not respond before its deadline", func() {
+			mockPDFClient.EXPECT().GetPDF(anyCtx, "", "", "en", "/a/bulletin").DoAndReturn(
+				func(ctx context.Context, userAccessToken, collectionID, lang, uri string) (*pdf.PDF, error) {
+					<-ctx.Done()
+					return nil, fmt.Errorf("failed to get response for pdf: %w", ctx.Err())
+				})
+
+			_, err := pc.GetPDF(ctx, "", "", "en", "/a/bulletin")
+
+			Convey("Then a timeout error is returned that matches context.DeadlineExceeded", func() {
+				var timeoutErr *Error
+				So(errors.As(err, &timeoutErr), ShouldBeTrue)
+				So(timeoutErr.Service, ShouldEqual, "pdf-service")
+				So(errors.Is(err, context.DeadlineExceeded), ShouldBeTrue)
+			})
+		})
+
+		Convey("When the PDF service responds in time", func() {
+			var callCtx context.Context
+			mockPDFClient.EXPECT().GetPDF(anyCtx, "", "", "en", "/a/bulletin").DoAndReturn(
+				func(ctx context.Context, userAccessToken, collectionID, lang, uri string) (*pdf.PDF, error) {
+					callCtx = ctx
+					return &pdf.PDF{Body: io.NopCloser(strings.NewReader("%PDF-1.4"))}, nil
+				})
+
+			p, err := pc.GetPDF(ctx, "", "", "en", "/a/bulletin")
+			So(err, ShouldBeNil)
+			time.Sleep(20 * time.Millisecond)
+
+			Convey("Then the PDF can be streamed after the deadline", func() {
+				So(callCtx.Err(), ShouldBeNil)
+				b, err := io.ReadAll(p.Body)
+				So(err, ShouldBeNil)
+				So(string(b), ShouldEqual, "%PDF-1.4")
+			})
+
+			Convey("Then the call is cancelled once the PDF is closed", func() {
+				So(p.Body.Close(), ShouldBeNil)
+				So(callCtx.Err(), ShouldEqual, context.Canceled)
+			})
+		})
+	})
+}
@@ -0,0 +1,108 @@
+package tracing
+
+import (
+	"context"
+
+	"github.com/ONSdigital/dp-api-clients-go/v2/articles"
+	"github.com/ONSdigital/dp-api-clients-go/v2/zebedee"
+	"github.com/ONSdigital/dp-frontend-articles-controller/handlers"
+	"github.com/ONSdigital/dp-frontend-articles-controller/pdf"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const instrumentationName = "github.com/ONSdigital/dp-frontend-articles-controller/tracing"
+
+// startSpan starts a span for a call to an upstream service
+func startSpan(ctx context.Context, name string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	return otel.Tracer(instrumentationName).Start(ctx, name, trace.WithSpanKind(trace.SpanKindClient), trace.WithAttributes(attrs...))
+}
+
+// endSpan records the outcome of a call to an upstream service and ends its span
+func endSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
+
+// ArticlesAPIClient traces calls to the Articles API
+type ArticlesAPIClient struct {
+	handlers.ArticlesApiClient
+}
+
+// NewArticlesAPIClient wraps an Articles API client with tracing
+func NewArticlesAPIClient(ac handlers.ArticlesApiClient) *ArticlesAPIClient {
+	return &ArticlesAPIClient{ArticlesApiClient: ac}
+}
+
+// GetLegacyBulletin returns a legacy bulletin
+func (c *ArticlesAPIClient) GetLegacyBulletin(ctx context.Context, userAccessToken, collectionID, lang, uri string) (*articles.Bulletin, error) {
+	ctx, span := startSpan(ctx, "articles-api.GetLegacyBulletin", attribute.String("uri", uri), attribute.String("lang", lang))
+	bulletin, err := c.ArticlesApiClient.GetLegacyBulletin(ctx, userAccessToken, collectionID, lang, uri)
+	endSpan(span, err)
+	return bulletin, err
+}
+
+// ZebedeeClient traces calls to Zebedee
+type ZebedeeClient struct {
+	handlers.ZebedeeClient
+}
+
+// NewZebedeeClient wraps a Zebedee client with tracing
+func NewZebedeeClient(zc handlers.ZebedeeClient) *ZebedeeClient {
+	return &ZebedeeClient{ZebedeeClient: zc}
+}
+
+// GetBreadcrumb returns the breadcrumb of a page
+func (c *ZebedeeClient) GetBreadcrumb(ctx context.Context, userAccessToken, collectionID, lang, uri string) ([]zebedee.Breadcrumb, error) {
+	ctx, span := startSpan(ctx, "zebedee.GetBreadcrumb", attribute.String("uri", uri), attribute.String("lang", lang))
+	breadcrumbs, err := c.ZebedeeClient.GetBreadcrumb(ctx, userAccessToken, collectionID, lang, uri)
+	endSpan(span, err)
+	return breadcrumbs, err
+}
+
+// GetHomepageContent returns the content of the homepage
+func (c *ZebedeeClient) GetHomepageContent(ctx context.Context, userAccessToken, collectionID, lang, path string) (zebedee.HomepageContent, error) {
+	ctx, span := startSpan(ctx, "zebedee.GetHomepageContent", attribute.String("uri", path), attribute.String("lang", lang))
+	content, err := c.ZebedeeClient.GetHomepageContent(ctx, userAccessToken, collectionID, lang, path)
+	endSpan(span, err)
+	return content, err
+}
+
+// GetPageTitle returns the title of a page
+func (c *ZebedeeClient) GetPageTitle(ctx context.Context, userAccessToken, collectionID, lang, uri string) (zebedee.PageTitle, error) {
+	ctx, span := startSpan(ctx, "zebedee.GetPageTitle", attribute.String("uri", uri), attribute.String("lang", lang))
+	title, err := c.ZebedeeClient.GetPageTitle(ctx, userAccessToken, collectionID, lang, uri)
+	endSpan(span, err)
+	return title, err
+}
+
+// Get returns the response body of a request to Zebedee
+func (c *ZebedeeClient) Get(ctx context.Context, userAccessToken, path string) ([]byte, error) {
+	ctx, span := startSpan(ctx, "zebedee.Get", attribute.String("path", path))
+	b, err := c.ZebedeeClient.Get(ctx, userAccessToken, path)
+	endSpan(span, err)
+	return b, err
+}
+
+// PDFClient traces calls to the PDF service
+type PDFClient struct {
+	handlers.PDFClient
+}
+
+// NewPDFClient wraps a PDF client with tracing
+func NewPDFClient(pc handlers.PDFClient) *PDFClient {
+	return &PDFClient{PDFClient: pc}
+}
+
+// GetPDF returns the PDF version of a page
+func (c *PDFClient) GetPDF(ctx context.Context, userAccessToken, collectionID, lang, uri string) (*pdf.PDF, error) {
+	ctx, span := startSpan(ctx, "pdf-service.GetPDF", attribute.String("uri", uri), attribute.String("lang", lang))
+	p, err := c.PDFClient.GetPDF(ctx, userAccessToken, collectionID, lang, uri)
+	endSpan(span, err)
+	return p, err
+}
@@ -0,0 +1,76 @@
+package tracing
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/ONSdigital/dp-frontend-articles-controller/config"
+	"github.com/ONSdigital/dp-frontend-articles-controller/middleware"
+	dphttp "github.com/ONSdigital/dp-net/v2/http"
+	"github.com/ONSdigital/log.go/v2/log"
+	"github.com/gorilla/mux"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.12.0"
+)
+
+// untracedPaths are not traced, as they are requested by monitoring rather than users
+var untracedPaths = map[string]bool{
+	"/health":  true,
+	"/metrics": true,
+}
+
+// Init sets up the propagation of W3C trace context, and exports spans to the configured OTLP endpoint. Without an
+// endpoint the default no-op tracer provider is kept, so that the service runs without a collector while still
+// passing on the trace context of its requests. The returned function flushes and stops the exporter.
+func Init(ctx context.Context, cfg *config.Config) (shutdown func(context.Context) error, err error) {
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
+
+	if cfg.OTExporterOTLPEndpoint == "" {
+		log.Info(ctx, "no OTLP endpoint configured, spans will not be exported")
+		return func(context.Context) error { return nil }, nil
+	}
+
+	exporter, err := otlptracehttp.New(ctx,
+		otlptracehttp.WithEndpoint(cfg.OTExporterOTLPEndpoint),
+		otlptracehttp.WithInsecure(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(cfg.OTSamplingRatio))),
+		sdktrace.WithResource(resource.NewWithAttributes(semconv.SchemaURL, semconv.ServiceNameKey.String(cfg.OTServiceName))),
+	)
+	otel.SetTracerProvider(provider)
+
+	log.Info(ctx, "exporting spans", log.Data{"endpoint": cfg.OTExporterOTLPEndpoint, "sampling_ratio": cfg.OTSamplingRatio})
+	return provider.Shutdown, nil
+}
+
+// Middleware starts a span for each request served by next, named after the route of r that matches it rather than the
+// path so that requests for different pages are grouped together. It wraps the whole router, so that requests that
+// match no route are traced, and the middleware of the router, e.g. panic recovery, runs within the span.
+func Middleware(r *mux.Router, next http.Handler) http.Handler {
+	return otelhttp.NewHandler(next, "",
+		otelhttp.WithSpanNameFormatter(func(_ string, req *http.Request) string {
+			if template := middleware.RouteTemplate(r, req); template != "" {
+				return req.Method + " " + template
+			}
+			return req.Method
+		}),
+		otelhttp.WithFilter(func(req *http.Request) bool { return !untracedPaths[req.URL.Path] }),
+	)
+}
+
+// NewHTTPClient returns an HTTP client for upstream services that passes the trace context of a request on in its
+// traceparent header
+func NewHTTPClient() dphttp.Clienter {
+	return dphttp.NewClientWithTransport(otelhttp.NewTransport(dphttp.DefaultTransport))
+}
@@ -0,0 +1,157 @@
+package tracing
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ONSdigital/dp-frontend-articles-controller/config"
+	"github.com/ONSdigital/dp-frontend-articles-controller/handlers"
+	gomock "github.com/golang/mock/gomock"
+	"github.com/gorilla/mux"
+	. "github.com/smartystreets/goconvey/convey"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+const traceparent = "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01"
+
+// recordSpans replaces the global tracer provider with one that records spans in memory
+func recordSpans() *tracetest.SpanRecorder {
+	recorder := tracetest.NewSpanRecorder()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+	return recorder
+}
+
+func spanNames(recorder *tracetest.SpanRecorder) []string {
+	var names []string
+	for _, span := range recorder.Ended() {
+		names = append(names, span.Name())
+	}
+	return names
+}
+
+func TestUnitTracing(t *testing.T) {
+	ctx := context.Background()
+
+	Convey("Given tracing is initialised without an OTLP endpoint", t, func() {
+		shutdown, err := Init(ctx, &config.Config{})
+		So(err, ShouldBeNil)
+		So(shutdown(ctx), ShouldBeNil)
+		recorder := recordSpans()
+
+		r := mux.NewRouter()
+		r.Use(func(next http.Handler) http.Handler {
+			return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+				defer func() {
+					if recover() != nil {
+						w.WriteHeader(http.StatusInternalServerError)
+					}
+				}()
+				next.ServeHTTP(w, req)
+			})
+		})
+		r.Path("/health").HandlerFunc(func(w http.ResponseWriter, req *http.Request) {})
+		r.Path("/panic").HandlerFunc(func(w http.ResponseWriter, req *http.Request) { panic("test") })
+		r.Path("/a/{uri:.*}").Methods("GET").HandlerFunc(func(w http.ResponseWriter, req *http.Request) {})
+		router := Middleware(r, r)
+
+		Convey("When a page is requested with a traceparent header", func() {
+			req := httptest.NewRequest("GET", "/a/bulletin", nil)
+			req.Header.Set("traceparent", traceparent)
+			router.ServeHTTP(httptest.NewRecorder(), req)
+
+			Convey("Then a span named after the route continues the trace", func() {
+				spans := recorder.Ended()
+				So(spans, ShouldHaveLength, 1)
+				So(spans[0].Name(), ShouldEqual, "GET /a/{uri:.*}")
+				So(spans[0].SpanContext().TraceID().String(), ShouldEqual, "4bf92f3577b34da6a3ce929d0e0e4736")
+				So(spans[0].Parent().SpanID().String(), ShouldEqual, "00f067aa0ba902b7")
+			})
+		})
+
+		Convey("When a request matches no route", func() {
+			router.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest("GET", "/another/bulletin", nil))
+			router.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest("POST", "/a/bulletin", nil))
+
+			Convey("Then it is traced with a span named after the method", func() {
+				So(spanNames(recorder), ShouldResemble, []string{"GET", "POST"})
+			})
+		})
+
+		Convey("When a handler panics", func() {
+			router.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest("GET", "/panic", nil))
+
+			Convey("Then the response of the router middleware that recovers is recorded on the span", func() {
+				spans := recorder.Ended()
+				So(spans, ShouldHaveLength, 1)
+				So(spans[0].Name(), ShouldEqual, "GET /panic")
+				So(spans[0].Status().Code, ShouldEqual, codes.Error)
+			})
+		})
+
+		Convey("When the health check is requested", func() {
+			router.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest("GET", "/health", nil))
+
+			Convey("Then it is not traced", func() {
+				So(recorder.Ended(), ShouldBeEmpty)
+			})
+		})
+
+		Convey("When an upstream service is called with the HTTP client", func() {
+			var received string
+			upstream := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+				received = req.Header.Get("traceparent")
+			}))
+			defer upstream.Close()
+
+			ctx, span := otel.Tracer("test").Start(ctx, "request")
+			req, _ := http.NewRequest("GET", upstream.URL, nil)
+			resp, err := NewHTTPClient().Do(ctx, req)
+			So(err, ShouldBeNil)
+			resp.Body.Close()
+			span.End()
+
+			Convey("Then the trace context is passed on in the traceparent header", func() {
+				So(received, ShouldStartWith, "00-"+span.SpanContext().TraceID().String()+"-")
+			})
+		})
+	})
+}
+
+func TestUnitClients(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	ctx := context.Background()
+	anyCtx := gomock.Any()
+
+	Convey("Given clients wrapped with tracing", t, func() {
+		recorder := recordSpans()
+		mockArticlesApiClient := handlers.NewMockArticlesApiClient(mockCtrl)
+		mockZebedeeClient := handlers.NewMockZebedeeClient(mockCtrl)
+		ac := NewArticlesAPIClient(mockArticlesApiClient)
+		zc := NewZebedeeClient(mockZebedeeClient)
+
+		Convey("When upstream calls are made", func() {
+			mockArticlesApiClient.EXPECT().GetLegacyBulletin(anyCtx, "", "", "en", "/a/bulletin")
+			mockZebedeeClient.EXPECT().GetBreadcrumb(anyCtx, "", "", "en", "/a/bulletin").Return(nil, errors.New("zebedee is down"))
+			ac.GetLegacyBulletin(ctx, "", "", "en", "/a/bulletin")
+			zc.GetBreadcrumb(ctx, "", "", "en", "/a/bulletin")
+
+			Convey("Then a span is recorded for each call", func() {
+				So(spanNames(recorder), ShouldResemble, []string{"articles-api.GetLegacyBulletin", "zebedee.GetBreadcrumb"})
+			})
+
+			Convey("And failed calls are marked as errors", func() {
+				spans := recorder.Ended()
+				So(spans[0].Status().Code, ShouldEqual, codes.Unset)
+				So(spans[1].Status().Code, ShouldEqual, codes.Error)
+				So(spans[1].Events(), ShouldHaveLength, 1)
+			})
+		})
+	})
+}
@@ -0,0 +1,39 @@
+package upstream
+
+import (
+	"context"
+	"errors"
+	"net"
+
+	"github.com/ONSdigital/dp-api-clients-go/v2/zebedee"
+)
+
+// statusCoder is implemented by the errors of the API clients that have the status of the upstream response
+type statusCoder interface {
+	Code() int
+}
+
+// Status returns the status of the upstream response that caused the error, if there was one
+func Status(err error) (int, bool) {
+	var zebedeeErr zebedee.ErrInvalidZebedeeResponse
+	if errors.As(err, &zebedeeErr) {
+		return zebedeeErr.ActualCode, true
+	}
+
+	var coder statusCoder
+	if errors.As(err, &coder) {
+		return coder.Code(), true
+	}
+
+	return 0, false
+}
+
+// IsTimeout reports whether the error is an upstream service not responding before the deadline of the call
+func IsTimeout(err error) bool {
+	if errors.Is(err, context.DeadlineExceeded) {
+		return true
+	}
+
+	var netErr net.Error
+	return errors.As(err, &netErr) && netErr.Timeout()
+}
@@ -0,0 +1,47 @@
+package upstream
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	dperrors "github.com/ONSdigital/dp-api-clients-go/v2/errors"
+	"github.com/ONSdigital/dp-api-clients-go/v2/zebedee"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+type timeoutError struct{}
+
+func (timeoutError) Error() string   { return "i/o timeout" }
+func (timeoutError) Timeout() bool   { return true }
+func (timeoutError) Temporary() bool { return true }
+
+func TestUnitStatus(t *testing.T) {
+	Convey("The status of the upstream response is returned from the errors of the clients", t, func() {
+		status, ok := Status(fmt.Errorf("getting bulletin: %w", dperrors.New(errors.New("articles api error"), 404, nil)))
+		So(ok, ShouldBeTrue)
+		So(status, ShouldEqual, 404)
+
+		status, ok = Status(zebedee.ErrInvalidZebedeeResponse{ActualCode: 502, URI: "/parents"})
+		So(ok, ShouldBeTrue)
+		So(status, ShouldEqual, 502)
+	})
+
+	Convey("No status is returned for an error without an upstream response", t, func() {
+		_, ok := Status(errors.New("connection refused"))
+		So(ok, ShouldBeFalse)
+	})
+}
+
+func TestUnitIsTimeout(t *testing.T) {
+	Convey("Deadlines and network timeouts are timeouts", t, func() {
+		So(IsTimeout(fmt.Errorf("getting bulletin: %w", context.DeadlineExceeded)), ShouldBeTrue)
+		So(IsTimeout(timeoutError{}), ShouldBeTrue)
+	})
+
+	Convey("Other errors are not timeouts", t, func() {
+		So(IsTimeout(context.Canceled), ShouldBeFalse)
+		So(IsTimeout(dperrors.New(errors.New("articles api error"), 504, nil)), ShouldBeFalse)
+	})
+}
@@ -0,0 +1,8 @@
+package upstream
+
+// Names of the upstream services, as logged and labelled in metrics
+const (
+	ArticlesAPI = "articles-api"
+	Zebedee     = "zebedee"
+	PDF         = "pdf-service"
+)